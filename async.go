@@ -0,0 +1,95 @@
+/**
+ * Copyright 2025 Alexis Bouchez <alexbcz@proton.me>
+ *
+ * This file is part of libhtml.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ */
+
+package html
+
+import (
+	"context"
+	"fmt"
+	"io"
+)
+
+// async renders the node produced by a function that may take a while
+type async struct {
+	fn func(ctx context.Context) (Node, error)
+}
+
+// asyncResult is the outcome of an async function
+type asyncResult struct {
+	node Node
+	err  error
+}
+
+// Async creates a node that calls fn when it is rendered and renders the
+// returned node, or fails with the returned error. Under RenderContext the
+// render gives up with the context's error once ctx is done, even when fn
+// doesn't watch ctx itself. Combined with RenderFlush, the markup before the
+// node reaches the client while fn is still fetching its data.
+// With a context that can't be canceled, such as under Render, fn is called
+// on the rendering goroutine; otherwise it runs on its own goroutine and a
+// panic in fn is returned as an error instead of crashing the program.
+func Async(fn func(ctx context.Context) (Node, error)) Node {
+	return &async{fn: fn}
+}
+
+// Render implements Node.Render for async
+func (a *async) Render(w io.Writer) error {
+	return a.RenderContext(context.Background(), w)
+}
+
+// RenderContext implements ContextNode for async
+func (a *async) RenderContext(ctx context.Context, w io.Writer) error {
+	if a.fn == nil {
+		return nil
+	}
+	if err := ctx.Err(); err != nil {
+		return err
+	}
+
+	var res asyncResult
+	if ctx.Done() == nil {
+		res.node, res.err = a.fn(ctx)
+	} else {
+		// The channel is buffered so fn's goroutine can finish after a cancel
+		done := make(chan asyncResult, 1)
+		go func() {
+			defer func() {
+				if r := recover(); r != nil {
+					done <- asyncResult{err: fmt.Errorf("html: async panic: %v", r)}
+				}
+			}()
+			n, err := a.fn(ctx)
+			done <- asyncResult{node: n, err: err}
+		}()
+
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case res = <-done:
+		}
+	}
+
+	if res.err != nil {
+		return res.err
+	}
+	if res.node == nil {
+		return nil
+	}
+	return RenderContext(ctx, res.node, w)
+}
@@ -0,0 +1,99 @@
+/**
+ * Copyright 2025 Alexis Bouchez <alexbcz@proton.me>
+ *
+ * This file is part of libhtml.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ */
+
+package html_test
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"testing"
+	"time"
+
+	. "github.com/alexisbcz/libhtml"
+)
+
+func TestAsync(t *testing.T) {
+	page := Div(
+		H1(Text("Orders")),
+		Async(func(ctx context.Context) (Node, error) {
+			return Ul(Li(Text("#1")), Li(Text("#2"))), nil
+		}),
+	)
+	want := "<div><h1>Orders</h1><ul><li>#1</li><li>#2</li></ul></div>"
+	if got := MustRenderString(page); got != want {
+		t.Errorf("expected: \"%s\"; got: \"%s\"", want, got)
+	}
+
+	if got := MustRenderString(Async(func(ctx context.Context) (Node, error) { return nil, nil })); got != "" {
+		t.Errorf("expected a nil node to render nothing; got: \"%s\"", got)
+	}
+}
+
+func TestAsyncError(t *testing.T) {
+	errFetch := errors.New("fetch failed")
+	_, err := RenderString(Div(Async(func(ctx context.Context) (Node, error) {
+		return nil, errFetch
+	})))
+	if !errors.Is(err, errFetch) {
+		t.Errorf("expected the fetch error; got: %v", err)
+	}
+}
+
+func TestAsyncCancel(t *testing.T) {
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Millisecond)
+	defer cancel()
+
+	release := make(chan struct{})
+	defer close(release)
+	n := Async(func(context.Context) (Node, error) {
+		<-release
+		return Text("late"), nil
+	})
+
+	var buf strings.Builder
+	if err := RenderContext(ctx, n, &buf); !errors.Is(err, context.DeadlineExceeded) {
+		t.Errorf("expected context.DeadlineExceeded; got: %v", err)
+	}
+	if buf.Len() != 0 {
+		t.Errorf("expected nothing to be written; got: \"%s\"", buf.String())
+	}
+}
+
+func TestAsyncPanic(t *testing.T) {
+	n := Async(func(context.Context) (Node, error) {
+		panic("boom")
+	})
+
+	func() {
+		defer func() {
+			if recover() == nil {
+				t.Error("expected the panic to reach the rendering goroutine under Render")
+			}
+		}()
+		_ = Render(n, &strings.Builder{})
+	}()
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	err := RenderContext(ctx, n, &strings.Builder{})
+	if err == nil || !strings.Contains(err.Error(), "boom") {
+		t.Errorf("expected the panic as an error; got: %v", err)
+	}
+}
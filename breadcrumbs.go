@@ -0,0 +1,87 @@
+/**
+ * Copyright 2025 Alexis Bouchez <alexbcz@proton.me>
+ *
+ * This file is part of libhtml.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ */
+
+package html
+
+// Crumb is an entry of a breadcrumb trail
+type Crumb struct {
+	// Label is the text of the entry
+	Label string
+
+	// Href links the entry, an entry without one is rendered as plain text
+	Href string
+}
+
+// Breadcrumbs creates a breadcrumb trail as a <nav aria-label="breadcrumb">
+// holding an ordered list of the items. The last item is the current page: it
+// is never linked and is marked with aria-current="page". Pair it with
+// BreadcrumbList for the matching structured data. Nothing is rendered for no
+// items.
+func Breadcrumbs(items []Crumb) Node {
+	if len(items) == 0 {
+		return Group()
+	}
+
+	lis := make([]Node, len(items))
+	for i, item := range items {
+		switch {
+		case i == len(items)-1:
+			lis[i] = Li(Text(item.Label)).Attribute("aria-current", "page")
+		case item.Href != "":
+			lis[i] = Li(A(Text(item.Label)).Href(item.Href))
+		default:
+			lis[i] = Li(Text(item.Label))
+		}
+	}
+
+	nav := Nav(Ol(lis...))
+	nav.Attribute("aria-label", "breadcrumb")
+	return nav
+}
+
+// breadcrumbList is the schema.org BreadcrumbList structured data
+type breadcrumbList struct {
+	Context  string           `json:"@context"`
+	Type     string           `json:"@type"`
+	Elements []breadcrumbItem `json:"itemListElement"`
+}
+
+// breadcrumbItem is an entry of a breadcrumbList
+type breadcrumbItem struct {
+	Type     string `json:"@type"`
+	Position int    `json:"position"`
+	Name     string `json:"name"`
+	Item     string `json:"item,omitempty"`
+}
+
+// BreadcrumbList creates the <script type="application/ld+json"> describing
+// the breadcrumb trail items as a schema.org BreadcrumbList, which search
+// engines show in their results. Search engines expect absolute URLs, so the
+// Href of the items should be absolute.
+func BreadcrumbList(items []Crumb) *script {
+	list := breadcrumbList{
+		Context:  "https://schema.org",
+		Type:     "BreadcrumbList",
+		Elements: make([]breadcrumbItem, len(items)),
+	}
+	for i, item := range items {
+		list.Elements[i] = breadcrumbItem{Type: "ListItem", Position: i + 1, Name: item.Label, Item: item.Href}
+	}
+	return JSONLD(list)
+}
@@ -0,0 +1,56 @@
+/**
+ * Copyright 2025 Alexis Bouchez <alexbcz@proton.me>
+ *
+ * This file is part of libhtml.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ */
+
+package html_test
+
+import (
+	"testing"
+
+	. "github.com/alexisbcz/libhtml"
+)
+
+var trail = []Crumb{
+	{Label: "Home", Href: "https://example.com/"},
+	{Label: "Docs"},
+	{Label: "Guide", Href: "https://example.com/docs/guide"},
+}
+
+func TestBreadcrumbs(t *testing.T) {
+	expected := `<nav aria-label="breadcrumb"><ol>` +
+		`<li><a href="https://example.com/">Home</a></li>` +
+		`<li>Docs</li>` +
+		`<li aria-current="page">Guide</li>` +
+		`</ol></nav>`
+	if got := MustRenderString(Breadcrumbs(trail)); got != expected {
+		t.Errorf("expected: \"%s\"; got: \"%s\"", expected, got)
+	}
+	if got := MustRenderString(Breadcrumbs(nil)); got != "" {
+		t.Errorf("expected no items to render nothing; got: \"%s\"", got)
+	}
+}
+
+func TestBreadcrumbList(t *testing.T) {
+	expected := `<script type="application/ld+json">{"@context":"https://schema.org","@type":"BreadcrumbList","itemListElement":[` +
+		`{"@type":"ListItem","position":1,"name":"Home","item":"https://example.com/"},` +
+		`{"@type":"ListItem","position":2,"name":"Docs"},` +
+		`{"@type":"ListItem","position":3,"name":"Guide","item":"https://example.com/docs/guide"}]}</script>`
+	if got := MustRenderString(BreadcrumbList(trail)); got != expected {
+		t.Errorf("expected: \"%s\"; got: \"%s\"", expected, got)
+	}
+}
@@ -0,0 +1,104 @@
+/**
+ * Copyright 2025 Alexis Bouchez <alexbcz@proton.me>
+ *
+ * This file is part of libhtml.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ */
+
+package html
+
+import "maps"
+
+// Clone returns a deep copy of n that can be modified without affecting n
+// Elements are copied with a fresh attribute map and cloned children, and are
+// returned as *Tag, so the copy is modified with the Tag methods such as
+// Attribute or Class. Documents, groups, If, IfElse, Keyed, WithNonce and
+// WithMode are copied along with the nodes they hold. A Layout is copied with
+// its own slots, so filling the original afterwards doesn't change the copy.
+// Text, raw HTML and comments are immutable and shared. Nodes built from
+// callbacks, such as IfFunc, Map or Repeat, as well as components and other
+// custom nodes, can't be looked into and are shared as they are; as they
+// build their nodes on every render, those nodes can't be modified through
+// the original either.
+func Clone(n Node) Node {
+	c := &cloner{slots: make(map[*Slots]*Slots)}
+	return c.node(n)
+}
+
+// cloner deep copies a tree, pointing the slots of the cloned layouts to
+// their copied Slots
+type cloner struct {
+	slots map[*Slots]*Slots
+}
+
+// node deep copies n
+func (c *cloner) node(n Node) Node {
+	switch v := n.(type) {
+	case nil:
+		return nil
+	case element:
+		return c.tag(v.base())
+	case *document:
+		return &document{children: c.nodes(v.children), doctype: v.doctype}
+	case *group:
+		return &group{children: c.nodes(v.children)}
+	case *if_:
+		return &if_{condition: v.condition, then: c.node(v.then)}
+	case *ifElse:
+		return &ifElse{condition: v.condition, then: c.node(v.then), else_: c.node(v.else_)}
+	case *keyed:
+		return &keyed{key: v.key, node: c.node(v.node)}
+	case *withNonce:
+		return &withNonce{node: c.node(v.node), nonce: v.nonce}
+	case *withMode:
+		return &withMode{node: c.node(v.node), mode: v.mode}
+	case *layout:
+		slots := &Slots{}
+		c.slots[v.slots] = slots
+		for name, sn := range v.slots.nodes {
+			slots.Set(name, c.node(sn))
+		}
+		return &layout{slots: slots, root: c.node(v.root)}
+	case *slot:
+		// slots outside a cloned layout keep pointing to the original ones
+		if slots, ok := c.slots[v.slots]; ok {
+			return &slot{slots: slots, name: v.name}
+		}
+	}
+	return n
+}
+
+// tag deep copies t
+func (c *cloner) tag(t *Tag) *Tag {
+	return &Tag{
+		name:       t.name,
+		isVoid:     t.isVoid,
+		children:   c.nodes(t.children),
+		attributes: maps.Clone(t.attributes),
+		boolean:    maps.Clone(t.boolean),
+	}
+}
+
+// nodes clones every node of nodes
+func (c *cloner) nodes(nodes []Node) []Node {
+	if nodes == nil {
+		return nil
+	}
+	cloned := make([]Node, len(nodes))
+	for i, n := range nodes {
+		cloned[i] = c.node(n)
+	}
+	return cloned
+}
@@ -0,0 +1,81 @@
+/**
+ * Copyright 2025 Alexis Bouchez <alexbcz@proton.me>
+ *
+ * This file is part of libhtml.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ */
+
+package html_test
+
+import (
+	"testing"
+
+	. "github.com/alexisbcz/libhtml"
+)
+
+func TestClone(t *testing.T) {
+	base := Div(
+		H2(Text("Title")).Class("title"),
+		If(true, P(Text("body")).ID("body")),
+		Group(Span(Text("a")), Raw("<hr>")),
+	).Class("card")
+
+	expected := MustRenderString(base)
+
+	variant := Clone(base).(*Tag)
+	variant.AddClass("featured").Attribute("data-id", "1")
+	for _, title := range FindAllByClass(variant, "title") {
+		title.AddClass("big")
+	}
+	if body, ok := FindByID(variant, "body"); ok {
+		body.Attribute("hidden", "hidden")
+	}
+
+	if got := MustRenderString(base); got != expected {
+		t.Errorf("expected the original to be unchanged: \"%s\"; got: \"%s\"", expected, got)
+	}
+
+	expectedVariant := `<div class="card featured" data-id="1"><h2 class="title big">Title</h2>` +
+		`<p hidden="hidden" id="body">body</p><span>a</span><hr></div>`
+	if got := MustRenderString(variant); got != expectedVariant {
+		t.Errorf("expected: \"%s\"; got: \"%s\"", expectedVariant, got)
+	}
+
+	if Clone(nil) != nil {
+		t.Error("expected Clone(nil) to be nil")
+	}
+	doc := Document(HTML(Body()))
+	if got, expected := MustRenderString(Clone(doc)), MustRenderString(doc); got != expected {
+		t.Errorf("expected: \"%s\"; got: \"%s\"", expected, got)
+	}
+}
+
+func TestCloneLayout(t *testing.T) {
+	body := Div(Text("original"))
+	page := Layout(func(slots *Slots) Node {
+		return Main(slots.Slot("body"))
+	}).Fill("body", body)
+
+	copied := Clone(page)
+	page.Fill("body", Div(Text("changed")))
+	body.Class("mutated")
+
+	if got, expected := MustRenderString(copied), "<main><div>original</div></main>"; got != expected {
+		t.Errorf("expected: \"%s\"; got: \"%s\"", expected, got)
+	}
+	if got, expected := MustRenderString(page), "<main><div>changed</div></main>"; got != expected {
+		t.Errorf("expected: \"%s\"; got: \"%s\"", expected, got)
+	}
+}
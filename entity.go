@@ -0,0 +1,112 @@
+/**
+ * Copyright 2025 Alexis Bouchez <alexbcz@proton.me>
+ *
+ * This file is part of libhtml.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ */
+
+package html
+
+import (
+	"fmt"
+	"io"
+	"unicode/utf8"
+)
+
+// entityNames lists the named character references accepted by Entity: the
+// HTML 4 set plus a few common HTML5 additions
+var entityNames = map[string]bool{
+	"nbsp": true, "iexcl": true, "cent": true, "pound": true, "curren": true, "yen": true,
+	"brvbar": true, "sect": true, "uml": true, "copy": true, "ordf": true, "laquo": true, "not": true,
+	"shy": true, "reg": true, "macr": true, "deg": true, "plusmn": true, "sup2": true, "sup3": true,
+	"acute": true, "micro": true, "para": true, "middot": true, "cedil": true, "sup1": true,
+	"ordm": true, "raquo": true, "frac14": true, "frac12": true, "frac34": true, "iquest": true,
+	"Agrave": true, "Aacute": true, "Acirc": true, "Atilde": true, "Auml": true, "Aring": true,
+	"AElig": true, "Ccedil": true, "Egrave": true, "Eacute": true, "Ecirc": true, "Euml": true,
+	"Igrave": true, "Iacute": true, "Icirc": true, "Iuml": true, "ETH": true, "Ntilde": true,
+	"Ograve": true, "Oacute": true, "Ocirc": true, "Otilde": true, "Ouml": true, "times": true,
+	"Oslash": true, "Ugrave": true, "Uacute": true, "Ucirc": true, "Uuml": true, "Yacute": true,
+	"THORN": true, "szlig": true, "agrave": true, "aacute": true, "acirc": true, "atilde": true,
+	"auml": true, "aring": true, "aelig": true, "ccedil": true, "egrave": true, "eacute": true,
+	"ecirc": true, "euml": true, "igrave": true, "iacute": true, "icirc": true, "iuml": true,
+	"eth": true, "ntilde": true, "ograve": true, "oacute": true, "ocirc": true, "otilde": true,
+	"ouml": true, "divide": true, "oslash": true, "ugrave": true, "uacute": true, "ucirc": true,
+	"uuml": true, "yacute": true, "thorn": true, "yuml": true, "fnof": true, "Alpha": true,
+	"Beta": true, "Gamma": true, "Delta": true, "Epsilon": true, "Zeta": true, "Eta": true,
+	"Theta": true, "Iota": true, "Kappa": true, "Lambda": true, "Mu": true, "Nu": true, "Xi": true,
+	"Omicron": true, "Pi": true, "Rho": true, "Sigma": true, "Tau": true, "Upsilon": true,
+	"Phi": true, "Chi": true, "Psi": true, "Omega": true, "alpha": true, "beta": true, "gamma": true,
+	"delta": true, "epsilon": true, "zeta": true, "eta": true, "theta": true, "iota": true,
+	"kappa": true, "lambda": true, "mu": true, "nu": true, "xi": true, "omicron": true, "pi": true,
+	"rho": true, "sigmaf": true, "sigma": true, "tau": true, "upsilon": true, "phi": true,
+	"chi": true, "psi": true, "omega": true, "thetasym": true, "upsih": true, "piv": true,
+	"bull": true, "hellip": true, "prime": true, "Prime": true, "oline": true, "frasl": true,
+	"weierp": true, "image": true, "real": true, "trade": true, "alefsym": true, "larr": true,
+	"uarr": true, "rarr": true, "darr": true, "harr": true, "crarr": true, "lArr": true, "uArr": true,
+	"rArr": true, "dArr": true, "hArr": true, "forall": true, "part": true, "exist": true,
+	"empty": true, "nabla": true, "isin": true, "notin": true, "ni": true, "prod": true, "sum": true,
+	"minus": true, "lowast": true, "radic": true, "prop": true, "infin": true, "ang": true,
+	"and": true, "or": true, "cap": true, "cup": true, "int": true, "there4": true, "sim": true,
+	"cong": true, "asymp": true, "ne": true, "equiv": true, "le": true, "ge": true, "sub": true,
+	"sup": true, "nsub": true, "sube": true, "supe": true, "oplus": true, "otimes": true,
+	"perp": true, "sdot": true, "lceil": true, "rceil": true, "lfloor": true, "rfloor": true,
+	"lang": true, "rang": true, "loz": true, "spades": true, "clubs": true, "hearts": true,
+	"diams": true, "quot": true, "amp": true, "lt": true, "gt": true, "apos": true, "OElig": true,
+	"oelig": true, "Scaron": true, "scaron": true, "Yuml": true, "circ": true, "tilde": true,
+	"ensp": true, "emsp": true, "thinsp": true, "zwnj": true, "zwj": true, "lrm": true, "rlm": true,
+	"ndash": true, "mdash": true, "lsquo": true, "rsquo": true, "sbquo": true, "ldquo": true,
+	"rdquo": true, "bdquo": true, "dagger": true, "Dagger": true, "permil": true, "lsaquo": true,
+	"rsaquo": true, "euro": true, "check": true, "cross": true, "star": true, "starf": true,
+	"phone": true, "female": true, "male": true,
+}
+
+// entity represents a character reference such as &mdash; or &#8212;
+type entity struct {
+	name string
+	code rune
+}
+
+// Nbsp creates a non-breaking space (&nbsp;)
+func Nbsp() Node {
+	return &entity{name: "nbsp"}
+}
+
+// Entity creates a named character reference such as Entity("mdash") for &mdash;
+// Rendering fails if name is not a known entity name
+func Entity(name string) Node {
+	return &entity{name: name}
+}
+
+// EntityCode creates a numeric character reference such as &#8212; for r
+// Rendering fails if r is not a valid Unicode code point
+func EntityCode(r rune) Node {
+	return &entity{code: r}
+}
+
+// Render implements Node.Render for entity
+func (e *entity) Render(w io.Writer) error {
+	if e.name != "" {
+		if !entityNames[e.name] {
+			return fmt.Errorf("html: unknown entity %q", e.name)
+		}
+		_, err := fmt.Fprintf(w, "&%s;", e.name)
+		return err
+	}
+	if e.code <= 0 || !utf8.ValidRune(e.code) {
+		return fmt.Errorf("html: invalid entity code point %#x", e.code)
+	}
+	_, err := fmt.Fprintf(w, "&#%d;", e.code)
+	return err
+}
@@ -0,0 +1,64 @@
+/**
+ * Copyright 2025 Alexis Bouchez <alexbcz@proton.me>
+ *
+ * This file is part of libhtml.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ */
+
+package html_test
+
+import (
+	"testing"
+
+	. "github.com/alexisbcz/libhtml"
+)
+
+func TestEntity(t *testing.T) {
+	tests := []struct {
+		node     Node
+		expected string
+	}{
+		{Nbsp(), `&nbsp;`},
+		{Entity("mdash"), `&mdash;`},
+		{P(Text("1"), Entity("times"), Text("2")), `<p>1&times;2</p>`},
+		{EntityCode('—'), `&#8212;`},
+		{EntityCode('A'), `&#65;`},
+	}
+
+	for _, test := range tests {
+		got, err := RenderString(test.node)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if got != test.expected {
+			t.Errorf("expected: \"%s\"; got: \"%s\"", test.expected, got)
+		}
+	}
+}
+
+func TestEntityInvalid(t *testing.T) {
+	for _, n := range []Node{
+		Entity("bogus"),
+		Entity("amp;<script>"),
+		Entity(""),
+		EntityCode(-1),
+		EntityCode(0xD800),
+		EntityCode(0x110000),
+	} {
+		if got, err := RenderString(n); err == nil {
+			t.Errorf("expected an error; got: \"%s\"", got)
+		}
+	}
+}
@@ -0,0 +1,177 @@
+/**
+ * Copyright 2025 Alexis Bouchez <alexbcz@proton.me>
+ *
+ * This file is part of libhtml.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ */
+
+package html
+
+import (
+	"bufio"
+	"errors"
+	"fmt"
+	"io"
+	"maps"
+	"os"
+	"path/filepath"
+	"runtime"
+	"slices"
+	"strings"
+	"sync"
+)
+
+// RenderFile renders n into the file at path, creating it or truncating an
+// existing one. The output is buffered and the file is synced before it is
+// closed; on failure the partial file is removed.
+func RenderFile(n Node, path string) error {
+	return writeFile(path, func(w io.Writer) error {
+		if n == nil {
+			return nil
+		}
+		return n.Render(w)
+	})
+}
+
+// RenderFileGzip renders n gzip-compressed into the file at path like
+// RenderFile, at a compression level accepted by RenderGzip, typically for
+// precompressed pages stored next to the originals as path.gz
+func RenderFileGzip(n Node, path string, level int) error {
+	return writeFile(path, func(w io.Writer) error {
+		return RenderGzip(n, w, level)
+	})
+}
+
+// writeFile creates the file at path and writes it with write through a
+// buffered writer, removing the file again if anything fails
+func writeFile(path string, write func(w io.Writer) error) error {
+	f, err := os.Create(path)
+	if err != nil {
+		return err
+	}
+	bw := bufio.NewWriter(f)
+	err = write(bw)
+	if err == nil {
+		err = bw.Flush()
+	}
+	if err == nil {
+		err = f.Sync()
+	}
+	if closeErr := f.Close(); err == nil {
+		err = closeErr
+	}
+	if err != nil {
+		return errors.Join(err, os.Remove(path))
+	}
+	return nil
+}
+
+// RenderSite renders every page into outDir with RenderFile, the keys of pages
+// being slash-separated paths relative to outDir such as "blog/post.html", a
+// leading slash being ignored. A path that is empty or ends with a slash gets
+// an "index.html" file. Pages resolving to the same file are reported before
+// anything is written. Missing directories are created and pages are rendered
+// concurrently, so nodes shared between pages must be safe for concurrent
+// rendering. Every failing page is reported in the returned error, naming its
+// path.
+func RenderSite(pages map[string]Node, outDir string) error {
+	return renderSite(pages, outDir, func(n Node, path string) error {
+		return RenderFile(n, path)
+	})
+}
+
+// RenderSiteGzip renders the pages like RenderSite and also writes each of
+// them gzip-compressed at the given level next to it, as "post.html.gz" for
+// "post.html"
+func RenderSiteGzip(pages map[string]Node, outDir string, level int) error {
+	return renderSite(pages, outDir, func(n Node, path string) error {
+		if err := RenderFile(n, path); err != nil {
+			return err
+		}
+		return RenderFileGzip(n, path+".gz", level)
+	})
+}
+
+// renderSite writes every page with write from a pool of workers
+// Pages resolving to the same file are reported before anything is written,
+// as workers would otherwise race to write it
+func renderSite(pages map[string]Node, outDir string, write func(n Node, path string) error) error {
+	names := slices.Sorted(maps.Keys(pages))
+	errs := make([]error, len(names))
+
+	paths := make([]string, len(names))
+	seen := make(map[string]string, len(names))
+	var conflicts []error
+	for i, name := range names {
+		path, err := pagePath(name, outDir)
+		if err != nil {
+			errs[i] = fmt.Errorf("html: page %s: %w", name, err)
+			continue
+		}
+		if other, ok := seen[path]; ok {
+			conflicts = append(conflicts, fmt.Errorf("html: pages %q and %q both write %s", other, name, path))
+			continue
+		}
+		seen[path] = name
+		paths[i] = path
+	}
+	if len(conflicts) > 0 {
+		return errors.Join(conflicts...)
+	}
+
+	jobs := make(chan int)
+	var wg sync.WaitGroup
+	for range min(runtime.GOMAXPROCS(0), len(names)) {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for i := range jobs {
+				if err := renderPage(pages[names[i]], paths[i], write); err != nil {
+					errs[i] = fmt.Errorf("html: page %s: %w", names[i], err)
+				}
+			}
+		}()
+	}
+	for i := range names {
+		if errs[i] == nil {
+			jobs <- i
+		}
+	}
+	close(jobs)
+	wg.Wait()
+
+	return errors.Join(errs...)
+}
+
+// pagePath returns the path in outDir of the file of the page with the given name
+func pagePath(name, outDir string) (string, error) {
+	name = strings.TrimPrefix(name, "/")
+	if name == "" || strings.HasSuffix(name, "/") {
+		name += "index.html"
+	}
+	rel := filepath.FromSlash(name)
+	if !filepath.IsLocal(rel) {
+		return "", errors.New("path escapes the output directory")
+	}
+	return filepath.Join(outDir, rel), nil
+}
+
+// renderPage writes a page to path, creating its directory
+func renderPage(n Node, path string, write func(n Node, path string) error) error {
+	if err := os.MkdirAll(filepath.Dir(path), 0o755); err != nil {
+		return err
+	}
+	return write(n, path)
+}
@@ -0,0 +1,161 @@
+/**
+ * Copyright 2025 Alexis Bouchez <alexbcz@proton.me>
+ *
+ * This file is part of libhtml.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ */
+
+package html_test
+
+import (
+	"compress/gzip"
+	"errors"
+	"io"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	. "github.com/alexisbcz/libhtml"
+)
+
+func TestRenderFile(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "index.html")
+	if err := os.WriteFile(path, []byte("stale content that is longer"), 0o644); err != nil {
+		t.Fatal(err)
+	}
+
+	if err := RenderFile(Document(HTML(Body(H1(Text("Home"))))), path); err != nil {
+		t.Fatal(err)
+	}
+	got, err := os.ReadFile(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if expected := "<!DOCTYPE html><html><body><h1>Home</h1></body></html>"; string(got) != expected {
+		t.Errorf("expected: \"%s\"; got: \"%s\"", expected, got)
+	}
+}
+
+func TestRenderFileError(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "broken.html")
+	if err := RenderFile(Div(failingNode{}), path); !errors.Is(err, errFailingNode) {
+		t.Fatalf("expected the render error; got: %v", err)
+	}
+	if _, err := os.Stat(path); !errors.Is(err, os.ErrNotExist) {
+		t.Errorf("expected the partial file to be removed; got: %v", err)
+	}
+
+	if err := RenderFile(P(), filepath.Join(t.TempDir(), "missing", "page.html")); err == nil {
+		t.Error("expected an error for a missing directory")
+	}
+}
+
+func TestRenderFileGzip(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "index.html.gz")
+	if err := RenderFileGzip(P(Text("compressed")), path, gzip.BestCompression); err != nil {
+		t.Fatal(err)
+	}
+
+	f, err := os.Open(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer f.Close()
+	zr, err := gzip.NewReader(f)
+	if err != nil {
+		t.Fatal(err)
+	}
+	got, err := io.ReadAll(zr)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if expected := "<p>compressed</p>"; string(got) != expected {
+		t.Errorf("expected: \"%s\"; got: \"%s\"", expected, got)
+	}
+}
+
+func TestRenderSite(t *testing.T) {
+	dir := t.TempDir()
+	pages := map[string]Node{
+		"":               Document(HTML(Body(H1(Text("Home"))))),
+		"about.html":     P(Text("About")),
+		"blog/":          P(Text("Blog")),
+		"blog/post.html": P(Text("Post")),
+	}
+	if err := RenderSiteGzip(pages, dir, gzip.BestSpeed); err != nil {
+		t.Fatal(err)
+	}
+
+	expected := map[string]string{
+		"index.html":      "<!DOCTYPE html><html><body><h1>Home</h1></body></html>",
+		"about.html":      "<p>About</p>",
+		"blog/index.html": "<p>Blog</p>",
+		"blog/post.html":  "<p>Post</p>",
+	}
+	for name, want := range expected {
+		got, err := os.ReadFile(filepath.Join(dir, filepath.FromSlash(name)))
+		if err != nil {
+			t.Fatal(err)
+		}
+		if string(got) != want {
+			t.Errorf("%s: expected: \"%s\"; got: \"%s\"", name, want, got)
+		}
+		if _, err := os.Stat(filepath.Join(dir, filepath.FromSlash(name)+".gz")); err != nil {
+			t.Errorf("%s: expected a gzip copy; got: %v", name, err)
+		}
+	}
+}
+
+func TestRenderSiteErrors(t *testing.T) {
+	dir := t.TempDir()
+	err := RenderSite(map[string]Node{
+		"ok.html":     P(Text("fine")),
+		"broken.html": Div(failingNode{}),
+		"../out.html": P(),
+	}, dir)
+
+	if !errors.Is(err, errFailingNode) {
+		t.Errorf("expected the render error; got: %v", err)
+	}
+	expected := "html: page ../out.html: path escapes the output directory\n" +
+		"html: page broken.html: rendering div: failing node"
+	if err == nil || err.Error() != expected {
+		t.Errorf("expected: \"%s\"; got: \"%v\"", expected, err)
+	}
+	if _, err := os.Stat(filepath.Join(dir, "ok.html")); err != nil {
+		t.Errorf("expected the other pages to be rendered; got: %v", err)
+	}
+}
+
+func TestRenderSiteConflicts(t *testing.T) {
+	dir := t.TempDir()
+	err := RenderSite(map[string]Node{
+		"":                P(Text("home")),
+		"/":               P(Text("root")),
+		"blog/":           P(Text("blog")),
+		"blog/index.html": P(Text("index")),
+		"about.html":      P(Text("about")),
+	}, dir)
+
+	for _, msg := range []string{`html: pages "" and "/" both write`, `html: pages "blog/" and "blog/index.html" both write`} {
+		if err == nil || !strings.Contains(err.Error(), msg) {
+			t.Errorf("expected the error to contain \"%s\"; got: \"%v\"", msg, err)
+		}
+	}
+	if _, err := os.Stat(filepath.Join(dir, "about.html")); !errors.Is(err, os.ErrNotExist) {
+		t.Errorf("expected nothing to be written; got: %v", err)
+	}
+}
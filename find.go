@@ -0,0 +1,83 @@
+/**
+ * Copyright 2025 Alexis Bouchez <alexbcz@proton.me>
+ *
+ * This file is part of libhtml.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ */
+
+package html
+
+import (
+	"slices"
+	"strings"
+)
+
+// walk calls fn for every element of the tree rooted at n in document order,
+// looking through groups, conditionals and other nodes standing in for their
+// children. It stops as soon as fn returns false and reports whether it went
+// through the whole tree.
+func walk(n Node, fn func(t *Tag) bool) bool {
+	for _, child := range flatten([]Node{n}) {
+		switch c := child.(type) {
+		case *document:
+			for _, dc := range c.children {
+				if !walk(dc, fn) {
+					return false
+				}
+			}
+		case element:
+			t := c.base()
+			if !fn(t) {
+				return false
+			}
+			for _, tc := range t.children {
+				if !walk(tc, fn) {
+					return false
+				}
+			}
+		}
+	}
+	return true
+}
+
+// FindByID returns the first element of the tree whose "id" attribute is id
+// An empty id is never found, as no element can have it
+func FindByID(root Node, id string) (*Tag, bool) {
+	if id == "" {
+		return nil, false
+	}
+	var found *Tag
+	walk(root, func(t *Tag) bool {
+		if t.attributes["id"] == id {
+			found = t
+			return false
+		}
+		return true
+	})
+	return found, found != nil
+}
+
+// FindAllByClass returns every element of the tree having class among the
+// space-separated tokens of its "class" attribute, in document order
+func FindAllByClass(root Node, class string) []*Tag {
+	var found []*Tag
+	walk(root, func(t *Tag) bool {
+		if slices.Contains(strings.Fields(t.attributes["class"]), class) {
+			found = append(found, t)
+		}
+		return true
+	})
+	return found
+}
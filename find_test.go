@@ -0,0 +1,90 @@
+/**
+ * Copyright 2025 Alexis Bouchez <alexbcz@proton.me>
+ *
+ * This file is part of libhtml.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ */
+
+package html_test
+
+import (
+	"testing"
+
+	. "github.com/alexisbcz/libhtml"
+)
+
+func TestFind(t *testing.T) {
+	items := []string{"a", "b", "c"}
+	tree := Document(HTML(Body(
+		Div(
+			H1(Text("Title")).ID("title"),
+			Map(items, func(item string) Node {
+				return Div(Text(item)).Class("card", "card-"+item)
+			}),
+			If(true, Span(Text("x")).Class("card")),
+			If(false, Span(Text("hidden")).Class("card").ID("hidden")),
+		).ID("main").Class("container"),
+	)))
+
+	title, ok := FindByID(tree, "title")
+	if !ok || title.TagName() != "h1" {
+		t.Fatalf("expected to find the h1 by id; got: %v, %v", title, ok)
+	}
+	if got := MustRenderString(title); got != `<h1 id="title">Title</h1>` {
+		t.Errorf("unexpected element found: \"%s\"", got)
+	}
+
+	if _, ok := FindByID(tree, "hidden"); ok {
+		t.Error("expected elements behind a false condition to be skipped")
+	}
+	if _, ok := FindByID(tree, "missing"); ok {
+		t.Error("expected no element for a missing id")
+	}
+	if _, ok := FindByID(tree, ""); ok {
+		t.Error("expected no element for an empty id")
+	}
+
+	cards := FindAllByClass(tree, "card")
+	if len(cards) != 4 {
+		t.Fatalf("expected 4 elements with class card; got: %d", len(cards))
+	}
+	if cards[3].TagName() != "span" {
+		t.Errorf("expected elements in document order; got last: %s", cards[3].TagName())
+	}
+	if got := FindAllByClass(tree, "card-b"); len(got) != 1 {
+		t.Errorf("expected 1 element with class card-b; got: %d", len(got))
+	}
+	if got := FindAllByClass(tree, "car"); len(got) != 0 {
+		t.Errorf("expected class matching on whole tokens; got: %d", len(got))
+	}
+}
+
+func TestTagAccessors(t *testing.T) {
+	img := Img().Attribute("src", "/a.png")
+	if img.TagName() != "img" || !img.IsVoid() {
+		t.Errorf("unexpected name or void flag: %s, %v", img.TagName(), img.IsVoid())
+	}
+
+	attrs := img.Attributes()
+	attrs["src"] = "/changed.png"
+	if got := MustRenderString(img); got != `<img src="/a.png"/>` {
+		t.Errorf("expected Attributes to return a copy; got: \"%s\"", got)
+	}
+
+	p := P(Text("a"), Text("b"))
+	if len(p.ChildNodes()) != 2 {
+		t.Errorf("expected 2 children; got: %d", len(p.ChildNodes()))
+	}
+}
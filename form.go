@@ -0,0 +1,133 @@
+/**
+ * Copyright 2025 Alexis Bouchez <alexbcz@proton.me>
+ *
+ * This file is part of libhtml.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ */
+
+package html
+
+import (
+	"strconv"
+	"strings"
+)
+
+// FieldOptions describes a labeled form field built by Field
+type FieldOptions struct {
+	// ID is the id of the input, which the label and messages refer to,
+	// defaulting to Name
+	ID string
+
+	// Label is the text of the <label>
+	Label string
+
+	// Type is the input type, "text" when empty
+	Type string
+
+	// Name and Value set the attributes of the input
+	Name  string
+	Value string
+
+	// Required marks the input as required
+	Required bool
+
+	// Error is a validation message shown in a <p role="alert">, which also
+	// marks the input with aria-invalid
+	Error string
+
+	// Help is a hint shown below the input
+	Help string
+}
+
+// Field creates an accessible form field: a <label> for the input, the input
+// itself and the optional help and error messages, wrapped in a <div>. The
+// label is tied to the input through for and id, and the messages through
+// aria-describedby with the ids ID+"-help" and ID+"-error".
+func Field(opts FieldOptions) *div {
+	id := opts.ID
+	if id == "" {
+		id = opts.Name
+	}
+	typ := opts.Type
+	if typ == "" {
+		typ = "text"
+	}
+
+	in := Input().Type(typ).Name(opts.Name).ValueIf(opts.Value != "", opts.Value)
+	in.ID(id)
+	in.BooleanAttributeIf(opts.Required, "required")
+
+	var describedBy []string
+	var help, alert Node
+	if opts.Help != "" {
+		p := P(Text(opts.Help))
+		p.IDIf(id != "", id+"-help")
+		help = p
+		describedBy = append(describedBy, id+"-help")
+	}
+	if opts.Error != "" {
+		p := P(Text(opts.Error))
+		p.IDIf(id != "", id+"-error").Role("alert")
+		alert = p
+		describedBy = append(describedBy, id+"-error")
+		in.Attribute("aria-invalid", "true")
+	}
+	if id != "" {
+		in.Attribute("aria-describedby", strings.Join(describedBy, " "))
+	}
+
+	return Div(
+		Label(Text(opts.Label)).ForIf(id != "", id),
+		in,
+		help,
+		alert,
+	)
+}
+
+// NameIndexed returns the bracketed name of a field in a repeated form
+// section, prefix[index][field], as parsed by frameworks that decode arrays
+// of structs from form values, e.g. NameIndexed("items", 0, "name") is
+// "items[0][name]". An empty field gives prefix[index], for arrays of values.
+func NameIndexed(prefix string, index int, field string) string {
+	name := prefix + "[" + strconv.Itoa(index) + "]"
+	if field != "" {
+		name += "[" + field + "]"
+	}
+	return name
+}
+
+// NameIndexedFunc returns a function building the NameIndexed names of the
+// section at index, to name every field of a section rendered with Repeat or
+// Map without repeating the prefix and index, e.g.
+//
+//	Repeat(len(items), func(i int) Node {
+//		name := NameIndexedFunc("items", i)
+//		return Div(
+//			Input().Name(name("name")).Value(items[i].Name),
+//			Input().Name(name("qty")).Value(strconv.Itoa(items[i].Qty)),
+//		)
+//	})
+func NameIndexedFunc(prefix string, index int) func(field string) string {
+	return func(field string) string {
+		return NameIndexed(prefix, index, field)
+	}
+}
+
+// NameArray sets the "name" attribute to NameIndexed(prefix, index, field)
+// Returns the element itself to enable method chaining
+func (e *input) NameArray(prefix string, index int, field string) *input {
+	e.Attribute("name", NameIndexed(prefix, index, field))
+	return e
+}
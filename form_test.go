@@ -0,0 +1,83 @@
+/**
+ * Copyright 2025 Alexis Bouchez <alexbcz@proton.me>
+ *
+ * This file is part of libhtml.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ */
+
+package html_test
+
+import (
+	"testing"
+
+	. "github.com/alexisbcz/libhtml"
+)
+
+func TestField(t *testing.T) {
+	tests := []struct {
+		opts     FieldOptions
+		expected string
+	}{
+		{
+			FieldOptions{Name: "email", Label: "Email", Type: "email"},
+			`<div><label for="email">Email</label><input id="email" name="email" type="email"/></div>`,
+		},
+		{
+			FieldOptions{
+				ID: "signup-email", Label: "Email", Name: "email", Value: "a@b", Required: true,
+				Help: "We never share it", Error: "Email is taken",
+			},
+			`<div><label for="signup-email">Email</label>` +
+				`<input aria-describedby="signup-email-help signup-email-error" aria-invalid="true" id="signup-email" name="email" required type="text" value="a@b"/>` +
+				`<p id="signup-email-help">We never share it</p>` +
+				`<p id="signup-email-error" role="alert">Email is taken</p></div>`,
+		},
+		{
+			FieldOptions{ID: "age", Label: "Age", Type: "number", Error: "Too young"},
+			`<div><label for="age">Age</label>` +
+				`<input aria-describedby="age-error" aria-invalid="true" id="age" type="number"/>` +
+				`<p id="age-error" role="alert">Too young</p></div>`,
+		},
+	}
+
+	for _, test := range tests {
+		if got := MustRenderString(Field(test.opts)); got != test.expected {
+			t.Errorf("expected: \"%s\"; got: \"%s\"", test.expected, got)
+		}
+	}
+}
+
+func TestNameIndexed(t *testing.T) {
+	if got := NameIndexed("items", 2, "name"); got != "items[2][name]" {
+		t.Errorf("expected: \"items[2][name]\"; got: \"%s\"", got)
+	}
+	if got := NameIndexed("tags", 0, ""); got != "tags[0]" {
+		t.Errorf("expected: \"tags[0]\"; got: \"%s\"", got)
+	}
+
+	items := []string{"apple", "pear"}
+	node := Repeat(len(items), func(i int) Node {
+		name := NameIndexedFunc("items", i)
+		return Div(
+			Input().Name(name("name")).Value(items[i]),
+			Input().NameArray("items", i, "qty").Value("1"),
+		)
+	})
+	expected := `<div><input name="items[0][name]" value="apple"/><input name="items[0][qty]" value="1"/></div>` +
+		`<div><input name="items[1][name]" value="pear"/><input name="items[1][qty]" value="1"/></div>`
+	if got := MustRenderString(node); got != expected {
+		t.Errorf("expected: \"%s\"; got: \"%s\"", expected, got)
+	}
+}
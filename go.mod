@@ -1,3 +1,5 @@
 module github.com/alexisbcz/libhtml
 
 go 1.24.3
+
+require golang.org/x/net v0.50.0
@@ -0,0 +1,72 @@
+/**
+ * Copyright 2025 Alexis Bouchez <alexbcz@proton.me>
+ *
+ * This file is part of libhtml.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ */
+
+package html
+
+import (
+	"maps"
+	"slices"
+	"strings"
+)
+
+// Charset creates the <meta charset> declaring the document encoding
+func Charset(c string) *meta {
+	return Meta().Charset(c)
+}
+
+// Viewport creates the standard responsive viewport meta,
+// width=device-width, initial-scale=1
+func Viewport() *meta {
+	return Meta().Name("viewport").Content("width=device-width, initial-scale=1")
+}
+
+// Description creates the meta description shown by search engines
+func Description(text string) *meta {
+	return Meta().Name("description").Content(text)
+}
+
+// OpenGraph creates an Open Graph <meta property> for every entry of props,
+// sorted by property. Keys are prefixed with "og:" unless they already contain
+// a prefix, so "title" and "og:title" are the same property, written once with
+// the value of "og:title", and "article:author" is kept.
+func OpenGraph(props map[string]string) Node {
+	values := make(map[string]string, len(props))
+	for key, value := range props {
+		property := key
+		if !strings.Contains(key, ":") {
+			property = "og:" + key
+			if _, ok := props[property]; ok {
+				continue
+			}
+		}
+		values[property] = value
+	}
+
+	metas := make([]Node, 0, len(values))
+	for _, property := range slices.Sorted(maps.Keys(values)) {
+		metas = append(metas, Meta().Property(property).Content(values[property]))
+	}
+	return Group(metas...)
+}
+
+// Canonical creates the <link rel="canonical"> pointing to the preferred URL
+// of the page
+func Canonical(href string) *link {
+	return Link().Rel("canonical").Href(href)
+}
@@ -0,0 +1,77 @@
+/**
+ * Copyright 2025 Alexis Bouchez <alexbcz@proton.me>
+ *
+ * This file is part of libhtml.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ */
+
+package html_test
+
+import (
+	"testing"
+
+	. "github.com/alexisbcz/libhtml"
+)
+
+func TestHeadHelpers(t *testing.T) {
+	head := Head(
+		Charset("utf-8"),
+		Viewport(),
+		Title(Text("Post")),
+		Description("A post about Go"),
+		Canonical("https://example.com/post"),
+		OpenGraph(map[string]string{
+			"title":          "Post",
+			"og:type":        "article",
+			"article:author": "Alexis",
+		}),
+	)
+	expected := `<head><meta charset="utf-8"/>` +
+		`<meta content="width=device-width, initial-scale=1" name="viewport"/>` +
+		`<title>Post</title>` +
+		`<meta content="A post about Go" name="description"/>` +
+		`<link href="https://example.com/post" rel="canonical"/>` +
+		`<meta content="Alexis" property="article:author"/>` +
+		`<meta content="Post" property="og:title"/>` +
+		`<meta content="article" property="og:type"/></head>`
+
+	if got := MustRenderString(head); got != expected {
+		t.Errorf("expected: \"%s\"; got: \"%s\"", expected, got)
+	}
+}
+
+func TestOpenGraphDuplicates(t *testing.T) {
+	got := MustRenderString(OpenGraph(map[string]string{"title": "short", "og:title": "explicit", "url": "/a"}))
+	if expected := `<meta content="explicit" property="og:title"/><meta content="/a" property="og:url"/>`; got != expected {
+		t.Errorf("expected: \"%s\"; got: \"%s\"", expected, got)
+	}
+}
+
+func TestMetaProperty(t *testing.T) {
+	tests := []struct {
+		node     Node
+		expected string
+	}{
+		{Meta().Property("og:title").Content("Hello"), `<meta content="Hello" property="og:title"/>`},
+		{Meta().PropertyIf(false, "og:url").Itemprop("name").Content("Widget"), `<meta content="Widget" itemprop="name"/>`},
+		{Meta().ItempropIf(true, "price").Content("9.99"), `<meta content="9.99" itemprop="price"/>`},
+	}
+
+	for _, test := range tests {
+		if got := MustRenderString(test.node); got != test.expected {
+			t.Errorf("expected: \"%s\"; got: \"%s\"", test.expected, got)
+		}
+	}
+}
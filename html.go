@@ -20,35 +20,204 @@
 package html
 
 import (
+	"cmp"
+	"context"
 	"fmt"
 	"html"
 	"io"
+	"maps"
+	"slices"
+	"strconv"
 	"strings"
 )
 
 // Attribute represents HTML element attributes
 type Attribute map[string]string
 
-// Node interface defines components that can render themselves
+// Node interface defines components that can render themselves as HTML
+// Rendering only reads the tree, so a tree that is no longer modified can be
+// rendered by many goroutines at once, such as a cached page served to
+// concurrent requests. Modifying a tree while it is rendered is not safe, and
+// the functions of lazy nodes like Map or IfFunc run on every render, so they
+// must be safe for concurrent use as well.
 type Node interface {
 	Render(w io.Writer) error
 }
 
+// ContextNode is implemented by nodes that can render with a context
+// The context carries cancellation and request-scoped values down the node tree
+type ContextNode interface {
+	Node
+	RenderContext(ctx context.Context, w io.Writer) error
+}
+
+// RenderContext renders the given node with ctx
+// Nodes that don't implement ContextNode are rendered with their Render method
+func RenderContext(ctx context.Context, n Node, w io.Writer) error {
+	if cn, ok := n.(ContextNode); ok {
+		return cn.RenderContext(ctx, w)
+	}
+	return n.Render(w)
+}
+
+// expander is implemented by nodes that render no markup of their own and
+// only stand in for other nodes, such as conditionals, maps and groups
+type expander interface {
+	expand() []Node
+}
+
+// wrapper is implemented by nodes that change how a single child renders,
+// such as Keyed, WithNonce and WithMode. Unlike expanders they can't be
+// replaced by their child when rendering, flattenContext resolves them
+// keeping what they add.
+type wrapper interface {
+	unwrap() Node
+}
+
+// flatten resolves expanders in nodes recursively and drops nil entries
+// Wrappers are looked through as well, dropping what they add at render time,
+// so flatten is meant for inspecting the tree; rendering uses flattenContext
+func flatten(nodes []Node) []Node {
+	flat := make([]Node, 0, len(nodes))
+	for _, n := range nodes {
+		switch v := n.(type) {
+		case nil:
+		case expander:
+			flat = append(flat, flatten(v.expand())...)
+		case wrapper:
+			flat = append(flat, flatten([]Node{v.unwrap()})...)
+		default:
+			flat = append(flat, n)
+		}
+	}
+	return flat
+}
+
+// contextNode is a node along with the context it is rendered with and the
+// key stamped on it by Keyed, if any
+type contextNode struct {
+	node Node
+	ctx  context.Context
+	key  string
+}
+
+// flattenContext resolves expanders in nodes like flatten, keeping track of
+// the context values set by WithNonce and WithMode for the nodes below them
+// and of the keys set by Keyed, the innermost key winning
+func flattenContext(ctx context.Context, nodes []Node, flat []contextNode) []contextNode {
+	for _, n := range nodes {
+		switch v := n.(type) {
+		case nil:
+		case *withNonce:
+			flat = flattenContext(context.WithValue(ctx, nonceKey{}, v.nonce), []Node{v.node}, flat)
+		case *withMode:
+			flat = flattenContext(context.WithValue(ctx, modeKey{}, v.mode), []Node{v.node}, flat)
+		case *keyed:
+			start := len(flat)
+			flat = flattenContext(ctx, []Node{v.node}, flat)
+			for i := start; i < len(flat); i++ {
+				if flat[i].key == "" {
+					flat[i].key = v.key
+				}
+			}
+		case expander:
+			flat = flattenContext(ctx, v.expand(), flat)
+		default:
+			flat = append(flat, contextNode{node: n, ctx: ctx})
+		}
+	}
+	return flat
+}
+
+// render renders the node with its context, stamping its key on it when it
+// is an element
+func (c contextNode) render(w io.Writer) error {
+	if e, ok := c.node.(element); ok && c.key != "" {
+		return e.base().renderContext(c.ctx, w, c.attributes())
+	}
+	return RenderContext(c.ctx, c.node, w)
+}
+
+// attributes returns the attributes added to the node at render time on top
+// of the ones its context adds
+func (c contextNode) attributes() []attr {
+	if c.key == "" {
+		return nil
+	}
+	return []attr{{key: KeyAttribute, value: c.key}}
+}
+
+// countingWriter wraps an io.Writer and keeps track of the bytes written to it
+type countingWriter struct {
+	w io.Writer
+	n int64
+}
+
+// Write implements io.Writer
+func (cw *countingWriter) Write(p []byte) (int, error) {
+	n, err := cw.w.Write(p)
+	cw.n += int64(n)
+	return n, err
+}
+
+// WriteString implements io.StringWriter
+func (cw *countingWriter) WriteString(s string) (int, error) {
+	n, err := io.WriteString(cw.w, s)
+	cw.n += int64(n)
+	return n, err
+}
+
+// writeCounted calls render with a counting writer and reports how many bytes it wrote
+// An existing counting writer is reused so nested nodes don't stack wrappers
+func writeCounted(w io.Writer, render func(w io.Writer) error) (int64, error) {
+	cw, ok := w.(*countingWriter)
+	if !ok {
+		cw = &countingWriter{w: w}
+	}
+	start := cw.n
+	err := render(cw)
+	return cw.n - start, err
+}
+
+// defaultDoctype is the doctype written by documents unless overridden
+const defaultDoctype = "<!DOCTYPE html>"
+
 // document represents an HTML document with its structure
 type document struct {
 	children []Node
+
+	// doctype is written before the children, nothing is written when empty
+	doctype string
 }
 
 // Render implements Node for document, rendering a complete HTML document
-func (d *document) Render(w io.Writer) (err error) {
-	if _, err := w.Write([]byte("<!DOCTYPE html>")); err != nil {
+func (d *document) Render(w io.Writer) error {
+	_, err := d.WriteTo(w)
+	return err
+}
+
+// WriteTo implements io.WriterTo for document
+func (d *document) WriteTo(w io.Writer) (int64, error) {
+	return writeCounted(w, func(w io.Writer) error {
+		return d.RenderContext(context.Background(), w)
+	})
+}
+
+// String implements fmt.Stringer, returning the rendered document
+func (d *document) String() string {
+	return renderDebugString(d)
+}
+
+// RenderContext implements ContextNode for document
+func (d *document) RenderContext(ctx context.Context, w io.Writer) error {
+	if _, err := io.WriteString(w, d.doctype); err != nil {
 		return err
 	}
 	for _, child := range d.children {
 		if child == nil {
 			continue
 		}
-		if err := child.Render(w); err != nil {
+		if err := RenderContext(ctx, child, w); err != nil {
 			return err
 		}
 	}
@@ -59,6 +228,7 @@ func (d *document) Render(w io.Writer) (err error) {
 func Document(children ...Node) *document {
 	return &document{
 		children: children,
+		doctype:  defaultDoctype,
 	}
 }
 
@@ -68,6 +238,21 @@ func (d *document) Children(children ...Node) *document {
 	return d
 }
 
+// Doctype overrides the doctype written before the children, "<!DOCTYPE html>"
+// by default, e.g. for a legacy XHTML doctype. It is written as-is without
+// escaping and an empty doctype writes none.
+func (d *document) Doctype(s string) *document {
+	d.doctype = s
+	return d
+}
+
+// Fragment combines nodes into a partial page rendered without a doctype,
+// such as the response to an AJAX request. It is the same as Group and only
+// differs in intent.
+func Fragment(children ...Node) Node {
+	return Group(children...)
+}
+
 // text renders escaped text content
 type text struct {
 	content string
@@ -83,12 +268,31 @@ func Textf(format string, args ...any) Node {
 	return &text{content: fmt.Sprintf(format, args...)}
 }
 
+// Space creates a node that renders a single space
+// Use it to separate inline siblings such as links, since the renderer never
+// adds whitespace between children; RenderMinified keeps it next to inline
+// elements as it is visible in the page
+func Space() Node {
+	return &text{content: " "}
+}
+
+// Newline creates a node that renders a line feed
+func Newline() Node {
+	return &text{content: "\n"}
+}
+
 // Render implements Node.Render for text
 func (t *text) Render(w io.Writer) error {
-	_, err := io.WriteString(w, html.EscapeString(t.content))
+	_, err := t.WriteTo(w)
 	return err
 }
 
+// WriteTo implements io.WriterTo for text
+func (t *text) WriteTo(w io.Writer) (int64, error) {
+	n, err := io.WriteString(w, html.EscapeString(t.content))
+	return int64(n), err
+}
+
 // raw renders content as-is without escaping
 type raw struct {
 	content string
@@ -106,10 +310,77 @@ func Rawf(format string, args ...any) Node {
 
 // Render implements Node.Render for raw
 func (r *raw) Render(w io.Writer) error {
-	_, err := io.WriteString(w, r.content)
+	_, err := r.WriteTo(w)
+	return err
+}
+
+// WriteTo implements io.WriterTo for raw
+func (r *raw) WriteTo(w io.Writer) (int64, error) {
+	n, err := io.WriteString(w, r.content)
+	return int64(n), err
+}
+
+// comment renders an HTML comment
+type comment struct {
+	content string
+}
+
+// escapeComment neutralizes the sequences that could end a comment early or
+// open a nested one: "-->", "--!>", "<!--", a leading ">" or "->" and a
+// trailing "<!-". Only the angle bracket of those is escaped, any other is
+// kept so conditional comments like "[if IE]>...<![endif]" still work.
+func escapeComment(s string) string {
+	if !strings.ContainsAny(s, "<>") {
+		return s
+	}
+	sb := strings.Builder{}
+	sb.Grow(len(s))
+	for i := 0; i < len(s); i++ {
+		switch c := s[i]; {
+		case c == '>' && (i == 0 || s[:i] == "-" || strings.HasSuffix(s[:i], "--") || strings.HasSuffix(s[:i], "--!")):
+			sb.WriteString("&gt;")
+		case c == '<' && (strings.HasPrefix(s[i+1:], "!--") || s[i+1:] == "!-"):
+			sb.WriteString("&lt;")
+		default:
+			sb.WriteByte(c)
+		}
+	}
+	return sb.String()
+}
+
+// Comment creates a node that renders an HTML comment <!--content-->
+// Sequences in content that would end the comment early are escaped, see
+// escapeComment
+// An empty content renders an empty comment <!---->
+func Comment(content string) Node {
+	return &comment{content: content}
+}
+
+// Commentf creates a node that renders a formatted HTML comment
+func Commentf(format string, args ...any) Node {
+	return &comment{content: fmt.Sprintf(format, args...)}
+}
+
+// Render implements Node.Render for comment
+func (c *comment) Render(w io.Writer) error {
+	_, err := c.WriteTo(w)
 	return err
 }
 
+// WriteTo implements io.WriterTo for comment
+func (c *comment) WriteTo(w io.Writer) (int64, error) {
+	return writeCounted(w, func(w io.Writer) error {
+		if _, err := io.WriteString(w, "<!--"); err != nil {
+			return err
+		}
+		if _, err := io.WriteString(w, escapeComment(c.content)); err != nil {
+			return err
+		}
+		_, err := io.WriteString(w, "-->")
+		return err
+	})
+}
+
 // if_ conditionally renders content based on a condition
 type if_ struct {
 	condition bool
@@ -139,21 +410,68 @@ func IfFunc(condition bool, thenFn func() Node) Node {
 	}
 }
 
+// Unless conditionally renders content when condition is false
+func Unless(condition bool, then Node) Node {
+	return If(!condition, then)
+}
+
+// UnlessFunc conditionally renders content when condition is false
+// Uses a callback function to avoid evaluating the content when condition is true
+func UnlessFunc(condition bool, thenFn func() Node) Node {
+	return IfFunc(!condition, thenFn)
+}
+
+// Opt returns n when condition is true and nil otherwise, so an optional child
+// can be passed inline as in Div().Children(header, Opt(showFooter, footer))
+// Unlike If there is no wrapper node: nil children are skipped when rendering.
+func Opt(condition bool, n Node) Node {
+	if !condition {
+		return nil
+	}
+	return n
+}
+
 // Render implements Node.Render for ifFunc
 func (i *ifFunc) Render(w io.Writer) error {
+	return i.RenderContext(context.Background(), w)
+}
+
+// RenderContext implements ContextNode for ifFunc
+func (i *ifFunc) RenderContext(ctx context.Context, w io.Writer) error {
 	if i.condition && i.thenFn != nil {
 		node := i.thenFn()
 		if node != nil {
-			return node.Render(w)
+			return RenderContext(ctx, node, w)
 		}
 	}
 	return nil
 }
 
+// expand implements expander for ifFunc
+func (i *ifFunc) expand() []Node {
+	if i.condition && i.thenFn != nil {
+		return []Node{i.thenFn()}
+	}
+	return nil
+}
+
 // Render implements Node.Render for if_
 func (i *if_) Render(w io.Writer) error {
+	return i.RenderContext(context.Background(), w)
+}
+
+// RenderContext implements ContextNode for if_
+func (i *if_) RenderContext(ctx context.Context, w io.Writer) error {
+	if i.condition {
+		return RenderContext(ctx, i.then, w)
+	}
+	return nil
+}
+
+// expand implements expander for if_
+func (i *if_) expand() []Node {
 	if i.condition {
-		return i.then.Render(w)
+		return []Node{i.then}
 	}
 	return nil
 }
@@ -176,10 +494,23 @@ func IfElse(condition bool, then Node, else_ Node) Node {
 
 // Render implements Node.Render for ifElse
 func (ie *ifElse) Render(w io.Writer) error {
+	return ie.RenderContext(context.Background(), w)
+}
+
+// RenderContext implements ContextNode for ifElse
+func (ie *ifElse) RenderContext(ctx context.Context, w io.Writer) error {
+	if ie.condition {
+		return RenderContext(ctx, ie.then, w)
+	}
+	return RenderContext(ctx, ie.else_, w)
+}
+
+// expand implements expander for ifElse
+func (ie *ifElse) expand() []Node {
 	if ie.condition {
-		return ie.then.Render(w)
+		return []Node{ie.then}
 	}
-	return ie.else_.Render(w)
+	return []Node{ie.else_}
 }
 
 // ifElseFunc is a lazy conditional renderer that only evaluates its content when true
@@ -201,14 +532,27 @@ func IfElseFunc(condition bool, thenFn, elseFn func() Node) Node {
 
 // Render implements Node.Render for ifElseFunc
 func (i *ifElseFunc) Render(w io.Writer) error {
+	return i.RenderContext(context.Background(), w)
+}
+
+// RenderContext implements ContextNode for ifElseFunc
+func (i *ifElseFunc) RenderContext(ctx context.Context, w io.Writer) error {
 	if i.condition && i.thenFn != nil {
 		node := i.thenFn()
 		if node != nil {
-			return node.Render(w)
+			return RenderContext(ctx, node, w)
 		}
 		return nil
 	}
-	return i.elseFn().Render(w)
+	return RenderContext(ctx, i.elseFn(), w)
+}
+
+// expand implements expander for ifElseFunc
+func (i *ifElseFunc) expand() []Node {
+	if i.condition && i.thenFn != nil {
+		return []Node{i.thenFn()}
+	}
+	return []Node{i.elseFn()}
 }
 
 // map_ renders a collection of items using a mapping function
@@ -227,15 +571,113 @@ func Map[T any](items []T, transform func(item T) Node) Node {
 
 // Render implements Node.Render for map_
 func (m *map_[T]) Render(w io.Writer) error {
+	return m.RenderContext(context.Background(), w)
+}
+
+// RenderContext implements ContextNode for map_
+// The context is checked between items so long lists stop early once it is done
+func (m *map_[T]) RenderContext(ctx context.Context, w io.Writer) error {
 	for _, item := range m.items {
+		if err := ctx.Err(); err != nil {
+			return err
+		}
 		node := m.transform(item)
-		if err := node.Render(w); err != nil {
+		if err := RenderContext(ctx, node, w); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// expand implements expander for map_
+func (m *map_[T]) expand() []Node {
+	nodes := make([]Node, 0, len(m.items))
+	for _, item := range m.items {
+		nodes = append(nodes, m.transform(item))
+	}
+	return nodes
+}
+
+// MapOf renders every entry of a Go map using a transform function
+// Entries are visited in Go's random map order, use SortedMapOf for deterministic output
+func MapOf[K comparable, V any](m map[K]V, transform func(k K, v V) Node) Node {
+	return Map(slices.Collect(maps.Keys(m)), func(k K) Node {
+		return transform(k, m[k])
+	})
+}
+
+// SortedMapOf renders every entry of a Go map using a transform function
+// Entries are visited in ascending key order
+func SortedMapOf[K cmp.Ordered, V any](m map[K]V, transform func(k K, v V) Node) Node {
+	return Map(slices.Sorted(maps.Keys(m)), func(k K) Node {
+		return transform(k, m[k])
+	})
+}
+
+// repeat renders a node a fixed number of times
+type repeat struct {
+	n  int
+	fn func(i int) Node
+}
+
+// Repeat renders fn(0) through fn(n-1) in order
+// A count of zero or less renders nothing
+func Repeat(n int, fn func(i int) Node) Node {
+	return &repeat{n: n, fn: fn}
+}
+
+// Render implements Node.Render for repeat
+func (r *repeat) Render(w io.Writer) error {
+	return r.RenderContext(context.Background(), w)
+}
+
+// RenderContext implements ContextNode for repeat
+// The context is checked between iterations like Map
+func (r *repeat) RenderContext(ctx context.Context, w io.Writer) error {
+	for i := 0; i < r.n; i++ {
+		if err := ctx.Err(); err != nil {
+			return err
+		}
+		node := r.fn(i)
+		if node == nil {
+			continue
+		}
+		if err := RenderContext(ctx, node, w); err != nil {
 			return err
 		}
 	}
 	return nil
 }
 
+// expand implements expander for repeat
+func (r *repeat) expand() []Node {
+	nodes := make([]Node, 0, max(r.n, 0))
+	for i := 0; i < r.n; i++ {
+		nodes = append(nodes, r.fn(i))
+	}
+	return nodes
+}
+
+// Coalesce renders the first non-nil node and nothing if all of them are nil
+func Coalesce(nodes ...Node) Node {
+	for _, n := range nodes {
+		if n != nil {
+			return n
+		}
+	}
+	return Group()
+}
+
+// Wrap renders children inside wrapper when cond is true and bare otherwise,
+// e.g. Wrap(url != "", A().Href(url).Tag, card...) for an optional link
+// The children replace those of wrapper, a nil wrapper renders the children bare
+func Wrap(cond bool, wrapper *Tag, children ...Node) Node {
+	if cond && wrapper != nil {
+		return wrapper.Children(children...)
+	}
+	return Group(children...)
+}
+
 // group represents a collection of nodes with no root element
 type group struct {
 	children []Node
@@ -246,19 +688,96 @@ func Group(children ...Node) Node {
 	return &group{children: children}
 }
 
+// Nodes renders a slice of nodes without a wrapper element, like Group does
+// for its arguments, which reads better when the slice is built dynamically
+// Nil entries are skipped. The slice is used as-is, not copied.
+func Nodes(ns []Node) Node {
+	return &group{children: ns}
+}
+
 // Render implements Node.Render for group
 func (g *group) Render(w io.Writer) error {
+	_, err := g.WriteTo(w)
+	return err
+}
+
+// WriteTo implements io.WriterTo for group
+func (g *group) WriteTo(w io.Writer) (int64, error) {
+	return writeCounted(w, func(w io.Writer) error {
+		return g.RenderContext(context.Background(), w)
+	})
+}
+
+// String implements fmt.Stringer, returning the rendered group
+func (g *group) String() string {
+	return renderDebugString(g)
+}
+
+// RenderContext implements ContextNode for group
+func (g *group) RenderContext(ctx context.Context, w io.Writer) error {
 	for _, child := range g.children {
 		if child == nil {
 			continue
 		}
-		if err := child.Render(w); err != nil {
+		if err := RenderContext(ctx, child, w); err != nil {
 			return err
 		}
 	}
 	return nil
 }
 
+// expand implements expander for group
+func (g *group) expand() []Node {
+	return g.children
+}
+
+// Component is implemented by reusable view structs
+// A component holds its own fields and renders itself, usually by building a
+// node tree from them and rendering it, so it can be used anywhere a Node is
+type Component interface {
+	Node
+}
+
+// Render renders the given component to w, a nil component renders nothing
+func Render(c Component, w io.Writer) error {
+	if c == nil {
+		return nil
+	}
+	return RenderWith(c, w, RenderOptions{})
+}
+
+// component adapts a Component to a Node
+type component struct {
+	c Component
+}
+
+// C wraps a component so it composes as a child of other nodes
+func C(c Component) Node {
+	return &component{c: c}
+}
+
+// Render implements Node.Render for component
+func (c *component) Render(w io.Writer) error {
+	return Render(c.c, w)
+}
+
+// RenderContext implements ContextNode for component
+func (c *component) RenderContext(ctx context.Context, w io.Writer) error {
+	if c.c == nil {
+		return nil
+	}
+	return RenderContext(ctx, c.c, w)
+}
+
+// voidElements lists the elements that never have children or a closing tag
+// It is the only such list: the element constructors, NewTagWith, Parse, the
+// JSON form and the sanitizer all look names up in it
+var voidElements = map[string]bool{
+	"area": true, "base": true, "br": true, "col": true, "embed": true, "hr": true,
+	"img": true, "input": true, "link": true, "meta": true, "param": true,
+	"source": true, "track": true, "wbr": true,
+}
+
 // Tag represents the base structure for all HTML elements
 type Tag struct {
 	// name of the HTML element (e.g., "div", "p", "a")
@@ -272,6 +791,9 @@ type Tag struct {
 
 	// attributes stores the element's HTML attributes
 	attributes map[string]string
+
+	// boolean marks the empty attributes rendered as a bare name, like <details open>
+	boolean map[string]bool
 }
 
 // NewTag creates a new Tag instance with specified properties
@@ -284,33 +806,133 @@ func NewTag(name string, isVoid bool, children []Node) *Tag {
 	}
 }
 
+// El creates an element with an arbitrary name, such as a custom element or a
+// tag without a dedicated constructor, e.g. El("my-widget")
+// It panics if name isn't a valid tag name, as that could inject markup
+func El(name string, children ...Node) *Tag {
+	mustBeValidTagName(name)
+	return NewTag(name, false, children)
+}
+
+// VoidEl creates a void element with an arbitrary name
+// Unlike El it takes no children, like Br or Img, since a void element is
+// never given a closing tag and so can't render any
+// It panics if name isn't a valid tag name, as that could inject markup
+func VoidEl(name string) *Tag {
+	mustBeValidTagName(name)
+	return NewTag(name, true, nil)
+}
+
+// mustBeValidTagName panics unless name is a valid tag name
+func mustBeValidTagName(name string) {
+	if name == "" {
+		panic("html: empty tag name")
+	}
+	if !isValidTagName(name) {
+		panic(fmt.Sprintf("html: invalid tag name %q", name))
+	}
+}
+
+// isValidTagName reports whether name starts with an ASCII letter and only
+// contains ASCII letters, digits, '-', '_', '.' and ':'
+func isValidTagName(name string) bool {
+	for i, r := range name {
+		switch {
+		case r >= 'a' && r <= 'z', r >= 'A' && r <= 'Z':
+		case i > 0 && (r >= '0' && r <= '9' || r == '-' || r == '_' || r == '.' || r == ':'):
+		default:
+			return false
+		}
+	}
+	return name != ""
+}
+
+// element is implemented by *Tag and, through embedding, by every element type
+type element interface {
+	base() *Tag
+}
+
+// base implements element
+func (e *Tag) base() *Tag {
+	return e
+}
+
+// TagName returns the name of the element, e.g. "div"
+func (e *Tag) TagName() string {
+	return e.name
+}
+
+// IsVoid reports whether the element is a void element that can't have children
+func (e *Tag) IsVoid() bool {
+	return e.isVoid
+}
+
+// Attributes returns a copy of the element attributes
+func (e *Tag) Attributes() Attribute {
+	return maps.Clone(Attribute(e.attributes))
+}
+
+// ChildNodes returns the children of the element
+func (e *Tag) ChildNodes() []Node {
+	return e.children
+}
+
 // Children set the children for a given tag.
+// Nil children are skipped when rendering, see Opt for optional children.
 func (e *Tag) Children(children ...Node) *Tag {
 	e.children = children
 	return e
 }
 
+// Append adds children after the current ones, nil children are skipped when
+// rendering like any other
+// The children slice given to the constructor is never written to, so tags
+// built from the same slice stay independent
+// Allows method chaining for fluent interface
+func (e *Tag) Append(children ...Node) *Tag {
+	e.children = append(slices.Clip(e.children), children...)
+	return e
+}
+
+// Prepend adds children before the current ones
+// Allows method chaining for fluent interface
+func (e *Tag) Prepend(children ...Node) *Tag {
+	e.children = slices.Concat(children, e.children)
+	return e
+}
+
 // Render implements Node.
 func (e *Tag) Render(w io.Writer) error {
-	if _, err := fmt.Fprintf(w, "<%s", e.name); err != nil {
-		return err
-	}
+	_, err := e.WriteTo(w)
+	return err
+}
 
-	// Render attributes
-	for key, value := range e.attributes {
-		if _, err := fmt.Fprintf(w, " %s=\"%s\"", key, value); err != nil {
-			return err
-		}
-	}
+// WriteTo implements io.WriterTo, returning the number of bytes written
+func (e *Tag) WriteTo(w io.Writer) (int64, error) {
+	return writeCounted(w, func(w io.Writer) error {
+		return e.RenderContext(context.Background(), w)
+	})
+}
 
-	if e.isVoid {
-		_, err := w.Write([]byte("/>"))
-		return err
-	}
+// String implements fmt.Stringer, returning the rendered markup so elements
+// print as HTML in logs and test failures
+func (e *Tag) String() string {
+	return renderDebugString(e)
+}
 
-	// Write closing bracket for opening tag
-	if _, err := w.Write([]byte(">")); err != nil {
-		return err
+// RenderContext implements ContextNode, passing ctx down to the children
+func (e *Tag) RenderContext(ctx context.Context, w io.Writer) error {
+	return e.renderContext(ctx, w, nil)
+}
+
+// renderContext renders the tag with extra attributes added at render time
+// Errors are wrapped in a RenderError recording the path to the tag
+func (e *Tag) renderContext(ctx context.Context, w io.Writer, extra []attr) error {
+	if err := e.writeOpen(w, append(e.contextAttributes(ctx), extra...), modeFrom(ctx)); err != nil {
+		return wrapRenderError(e.name, err)
+	}
+	if e.isVoid {
+		return nil
 	}
 
 	// Render all children
@@ -318,249 +940,305 @@ func (e *Tag) Render(w io.Writer) error {
 		if child == nil {
 			continue
 		}
-		if err := child.Render(w); err != nil {
-			return err
+		if err := RenderContext(ctx, child, w); err != nil {
+			return wrapRenderError(e.name, err)
+		}
+	}
+
+	if err := e.writeClose(w); err != nil {
+		return wrapRenderError(e.name, err)
+	}
+	return nil
+}
+
+// attr is a single attribute added to a tag at render time
+type attr struct {
+	key   string
+	value string
+}
+
+// contextAttributes returns the attributes ctx adds to the tag at render time,
+// such as the CSP nonce stamped on script and style elements by WithNonce
+func (e *Tag) contextAttributes(ctx context.Context) []attr {
+	if e.name != "script" && e.name != "style" {
+		return nil
+	}
+	if nonce, ok := ctx.Value(nonceKey{}).(string); ok {
+		return []attr{{key: "nonce", value: nonce}}
+	}
+	return nil
+}
+
+// writeOpen writes the opening tag with its attributes in the given mode
+// The extra attributes are added on top of the tag's own, taking precedence
+// Void elements are self-closed since they never get a closing tag
+func (e *Tag) writeOpen(w io.Writer, extra []attr, mode RenderMode) error {
+	// Assemble the whole opening tag in a scratch buffer so it reaches w in a
+	// single write
+	buf := getBuffer()
+	defer putBuffer(buf)
+
+	buf.WriteString("<")
+	buf.WriteString(e.name)
+
+	// Render attributes in a stable order, escaping their values so they
+	// cannot break out of the surrounding quotes
+	var scratch [8]string
+	keys := scratch[:0]
+	for key := range e.attributes {
+		keys = append(keys, key)
+	}
+	for _, a := range extra {
+		if _, ok := e.attributes[a.key]; !ok {
+			keys = append(keys, a.key)
+		}
+	}
+	slices.Sort(keys)
+	for _, key := range keys {
+		value := e.attributes[key]
+		for _, a := range extra {
+			if a.key == key {
+				value = a.value
+			}
+		}
+		buf.WriteString(" ")
+		buf.WriteString(key)
+		if value == "" && e.boolean[key] {
+			if mode.minimize() {
+				continue
+			}
+			value = key
 		}
+		buf.WriteString("=\"")
+		buf.WriteString(html.EscapeString(value))
+		buf.WriteString("\"")
+	}
+
+	if e.isVoid {
+		buf.WriteString(mode.voidEnd())
+	} else {
+		// Write closing bracket for opening tag
+		buf.WriteString(">")
 	}
 
-	// Write closing tag
-	_, err := fmt.Fprintf(w, "</%s>", e.name)
+	_, err := w.Write(buf.Bytes())
+	return err
+}
+
+// writeClose writes the closing tag
+func (e *Tag) writeClose(w io.Writer) error {
+	if _, err := io.WriteString(w, "</"); err != nil {
+		return err
+	}
+	if _, err := io.WriteString(w, e.name); err != nil {
+		return err
+	}
+	_, err := io.WriteString(w, ">")
 	return err
 }
 
 // Attribute adds or updates an attribute for the tag
+// Custom names like "x-data", "v-bind:value" or "@click" are accepted, but
+// names that could break out of the tag (containing whitespace, quotes, '=',
+// '<', '>' or '/') are ignored, as are empty values
 // Allows method chaining for fluent interface
 func (t *Tag) Attribute(key, value string) *Tag {
-	if value == "" {
+	if value == "" || !isValidAttributeName(key) {
 		return t
 	}
 	t.attributes[key] = value
+	delete(t.boolean, key)
 	return t
 }
 
-// Attribute adds or updates an attribute for the tag
+// AttributeIf conditionally adds or updates an attribute for the tag
+// Unlike Attribute an empty value is kept, names are validated the same way
 // Allows method chaining for fluent interface
 func (t *Tag) AttributeIf(cond bool, key, value string) *Tag {
-	if cond {
+	if cond && isValidAttributeName(key) {
 		t.attributes[key] = value
+		delete(t.boolean, key)
 	}
 	return t
 }
 
-// A represents the <a> HTML element
-type a struct {
-	// Embeds the base Tag to inherit core HTML element functionality
-	*Tag
-}
-
-// A creates a new a element
-// Allows optional child nodes to be passed during creation
-func A(children ...Node) *a {
-	return &a{NewTag("a", false, children)}
+// AttributeInt adds or updates an attribute for the tag with an integer value
+// Allows method chaining for fluent interface
+func (t *Tag) AttributeInt(key string, v int) *Tag {
+	return t.Attribute(key, strconv.Itoa(v))
 }
 
-// Style sets the "style" attribute
-// Returns the element itself to enable method chaining
-func (e *a) Style(value string) *a {
-	e.Attribute("style", value)
-	return e
+// BooleanAttribute sets a boolean attribute, rendered as its bare name such as
+// the "open" of <details open>, names are validated like Attribute does
+// Allows method chaining for fluent interface
+func (t *Tag) BooleanAttribute(key string) *Tag {
+	return t.BooleanAttributeIf(true, key)
 }
 
-// StyleIf conditionally sets the "style" attribute
-// Only sets the attribute if the condition is true
-func (e *a) StyleIf(condition bool, value string) *a {
-	if condition {
-		e.Attribute("style", value)
+// BooleanAttributeIf conditionally sets a boolean attribute
+// Allows method chaining for fluent interface
+func (t *Tag) BooleanAttributeIf(cond bool, key string) *Tag {
+	if cond && isValidAttributeName(key) {
+		t.attributes[key] = ""
+		if t.boolean == nil {
+			t.boolean = make(map[string]bool)
+		}
+		t.boolean[key] = true
 	}
-	return e
+	return t
 }
 
-// ID sets the "id" attribute
-// Returns the element itself to enable method chaining
-func (e *a) ID(value string) *a {
-	e.Attribute("id", value)
-	return e
+// RemoveAttribute removes an attribute from the tag, a missing key is a no-op
+// Allows method chaining for fluent interface
+func (t *Tag) RemoveAttribute(key string) *Tag {
+	delete(t.attributes, key)
+	delete(t.boolean, key)
+	return t
 }
 
-// IDIf conditionally sets the "id" attribute
-// Only sets the attribute if the condition is true
-func (e *a) IDIf(condition bool, value string) *a {
-	if condition {
-		e.Attribute("id", value)
-	}
-	return e
+// GetAttribute returns the value of an attribute and whether it is set
+func (t *Tag) GetAttribute(key string) (string, bool) {
+	value, ok := t.attributes[key]
+	return value, ok
 }
 
-// Class sets the "class" attribute
-// Returns the element itself to enable method chaining
-func (e *Tag) Class(values ...string) *Tag {
-	e.Attribute("class", strings.Join(values, " "))
-	return e
+// HasAttribute reports whether an attribute is set, even to an empty value
+func (t *Tag) HasAttribute(key string) bool {
+	_, ok := t.attributes[key]
+	return ok
 }
 
-// ClassIf conditionally sets the "class" attribute
-// Only sets the attribute if the condition is true
-func (e *Tag) ClassIf(condition bool, value string) *Tag {
-	if condition {
-		e.Attribute("class", value)
+// Attrs merges attrs into the tag, typically attributes passed through by the
+// caller of a component. Classes are appended to the current ones like
+// AddClass and styles are appended after the current declarations, every
+// other attribute is replaced. Empty values are kept like AttributeIf does.
+// Allows method chaining for fluent interface
+func (t *Tag) Attrs(attrs Attribute) *Tag {
+	for key, value := range attrs {
+		t.mergeAttribute(key, value)
 	}
-	return e
-}
-
-// Href sets the "href" attribute
-// Returns the element itself to enable method chaining
-func (e *a) Href(value string) *a {
-	e.Attribute("href", value)
-	return e
+	return t
 }
 
-// HrefIf conditionally sets the "href" attribute
-// Only sets the attribute if the condition is true
-func (e *a) HrefIf(condition bool, value string) *a {
-	if condition {
-		e.Attribute("href", value)
+// MergeAttrs merges the attributes of other into the tag like Attrs
+// Allows method chaining for fluent interface
+func (t *Tag) MergeAttrs(other *Tag) *Tag {
+	if other == nil {
+		return t
 	}
-	return e
-}
-
-// Target sets the "target" attribute
-// Returns the element itself to enable method chaining
-func (e *a) Target(value string) *a {
-	e.Attribute("target", value)
-	return e
-}
-
-// TargetIf conditionally sets the "target" attribute
-// Only sets the attribute if the condition is true
-func (e *a) TargetIf(condition bool, value string) *a {
-	if condition {
-		e.Attribute("target", value)
+	t.Attrs(other.attributes)
+	for key := range other.boolean {
+		if t.attributes[key] == "" {
+			t.BooleanAttribute(key)
+		}
 	}
-	return e
-}
-
-// Download sets the "download" attribute
-// Returns the element itself to enable method chaining
-func (e *a) Download(value string) *a {
-	e.Attribute("download", value)
-	return e
+	return t
 }
 
-// DownloadIf conditionally sets the "download" attribute
-// Only sets the attribute if the condition is true
-func (e *a) DownloadIf(condition bool, value string) *a {
-	if condition {
-		e.Attribute("download", value)
+// mergeAttribute merges a single attribute following the rules of Attrs
+func (t *Tag) mergeAttribute(key, value string) {
+	current, ok := t.attributes[key]
+	switch {
+	case !ok:
+		t.AttributeIf(true, key, value)
+	case key == "class":
+		t.appendClass([]string{value})
+	case key == "style":
+		if current = strings.TrimRight(strings.TrimSpace(current), ";"); current == "" {
+			t.attributes[key] = value
+		} else if strings.TrimSpace(value) != "" {
+			t.attributes[key] = current + ";" + value
+		}
+	default:
+		t.attributes[key] = value
 	}
-	return e
-}
-
-// Rel sets the "rel" attribute
-// Returns the element itself to enable method chaining
-func (e *a) Rel(value string) *a {
-	e.Attribute("rel", value)
-	return e
 }
 
-// RelIf conditionally sets the "rel" attribute
-// Only sets the attribute if the condition is true
-func (e *a) RelIf(condition bool, value string) *a {
-	if condition {
-		e.Attribute("rel", value)
+// isValidAttributeName reports whether name can be written as an attribute
+// name without changing the meaning of the surrounding markup
+func isValidAttributeName(name string) bool {
+	if name == "" {
+		return false
 	}
-	return e
-}
-
-// Type sets the "type" attribute
-// Returns the element itself to enable method chaining
-func (e *a) Type(value string) *a {
-	e.Attribute("type", value)
-	return e
-}
-
-// TypeIf conditionally sets the "type" attribute
-// Only sets the attribute if the condition is true
-func (e *a) TypeIf(condition bool, value string) *a {
-	if condition {
-		e.Attribute("type", value)
+	for _, r := range name {
+		if r <= ' ' || r == 0x7f || strings.ContainsRune("\"'<>/=", r) {
+			return false
+		}
 	}
-	return e
+	return true
 }
 
-// Hreflang sets the "hreflang" attribute
+// Style sets the "style" attribute
 // Returns the element itself to enable method chaining
-func (e *a) Hreflang(value string) *a {
-	e.Attribute("hreflang", value)
+func (e *Tag) Style(value string) *Tag {
+	e.Attribute("style", value)
 	return e
 }
 
-// HreflangIf conditionally sets the "hreflang" attribute
+// StyleIf conditionally sets the "style" attribute
 // Only sets the attribute if the condition is true
-func (e *a) HreflangIf(condition bool, value string) *a {
+func (e *Tag) StyleIf(condition bool, value string) *Tag {
 	if condition {
-		e.Attribute("hreflang", value)
+		e.Attribute("style", value)
 	}
 	return e
 }
 
-// Media sets the "media" attribute
+// StyleMap sets the "style" attribute from property/value pairs
+// Properties are written as-is in sorted order, as "property:value;", which
+// supports custom properties like "--accent". Empty values are skipped and an
+// empty map leaves the attribute unset.
 // Returns the element itself to enable method chaining
-func (e *a) Media(value string) *a {
-	e.Attribute("media", value)
-	return e
-}
-
-// MediaIf conditionally sets the "media" attribute
-// Only sets the attribute if the condition is true
-func (e *a) MediaIf(condition bool, value string) *a {
-	if condition {
-		e.Attribute("media", value)
+func (e *Tag) StyleMap(styles map[string]string) *Tag {
+	sb := &strings.Builder{}
+	for _, property := range slices.Sorted(maps.Keys(styles)) {
+		if styles[property] == "" {
+			continue
+		}
+		sb.WriteString(property)
+		sb.WriteString(":")
+		sb.WriteString(styles[property])
+		sb.WriteString(";")
 	}
+	e.Attribute("style", sb.String())
 	return e
 }
 
-// Ping sets the "ping" attribute
-// Returns the element itself to enable method chaining
-func (e *a) Ping(value string) *a {
-	e.Attribute("ping", value)
-	return e
-}
-
-// PingIf conditionally sets the "ping" attribute
+// StyleMapIf conditionally sets the "style" attribute from property/value pairs
 // Only sets the attribute if the condition is true
-func (e *a) PingIf(condition bool, value string) *a {
+func (e *Tag) StyleMapIf(condition bool, styles map[string]string) *Tag {
 	if condition {
-		e.Attribute("ping", value)
+		e.StyleMap(styles)
 	}
 	return e
 }
 
-// Referrerpolicy sets the "referrerpolicy" attribute
+// ID sets the "id" attribute
 // Returns the element itself to enable method chaining
-func (e *a) Referrerpolicy(value string) *a {
-	e.Attribute("referrerpolicy", value)
+func (e *Tag) ID(value string) *Tag {
+	e.Attribute("id", value)
 	return e
 }
 
-// ReferrerpolicyIf conditionally sets the "referrerpolicy" attribute
+// IDIf conditionally sets the "id" attribute
 // Only sets the attribute if the condition is true
-func (e *a) ReferrerpolicyIf(condition bool, value string) *a {
+func (e *Tag) IDIf(condition bool, value string) *Tag {
 	if condition {
-		e.Attribute("referrerpolicy", value)
+		e.Attribute("id", value)
 	}
 	return e
 }
 
 // Accesskey sets the "accesskey" attribute
 // Returns the element itself to enable method chaining
-func (e *a) Accesskey(value string) *a {
+func (e *Tag) Accesskey(value string) *Tag {
 	e.Attribute("accesskey", value)
 	return e
 }
 
 // AccesskeyIf conditionally sets the "accesskey" attribute
 // Only sets the attribute if the condition is true
-func (e *a) AccesskeyIf(condition bool, value string) *a {
+func (e *Tag) AccesskeyIf(condition bool, value string) *Tag {
 	if condition {
 		e.Attribute("accesskey", value)
 	}
@@ -569,14 +1247,14 @@ func (e *a) AccesskeyIf(condition bool, value string) *a {
 
 // Contenteditable sets the "contenteditable" attribute
 // Returns the element itself to enable method chaining
-func (e *a) Contenteditable(value string) *a {
+func (e *Tag) Contenteditable(value string) *Tag {
 	e.Attribute("contenteditable", value)
 	return e
 }
 
 // ContenteditableIf conditionally sets the "contenteditable" attribute
 // Only sets the attribute if the condition is true
-func (e *a) ContenteditableIf(condition bool, value string) *a {
+func (e *Tag) ContenteditableIf(condition bool, value string) *Tag {
 	if condition {
 		e.Attribute("contenteditable", value)
 	}
@@ -585,14 +1263,14 @@ func (e *a) ContenteditableIf(condition bool, value string) *a {
 
 // Dir sets the "dir" attribute
 // Returns the element itself to enable method chaining
-func (e *a) Dir(value string) *a {
+func (e *Tag) Dir(value string) *Tag {
 	e.Attribute("dir", value)
 	return e
 }
 
 // DirIf conditionally sets the "dir" attribute
 // Only sets the attribute if the condition is true
-func (e *a) DirIf(condition bool, value string) *a {
+func (e *Tag) DirIf(condition bool, value string) *Tag {
 	if condition {
 		e.Attribute("dir", value)
 	}
@@ -601,14 +1279,14 @@ func (e *a) DirIf(condition bool, value string) *a {
 
 // Draggable sets the "draggable" attribute
 // Returns the element itself to enable method chaining
-func (e *a) Draggable(value string) *a {
+func (e *Tag) Draggable(value string) *Tag {
 	e.Attribute("draggable", value)
 	return e
 }
 
 // DraggableIf conditionally sets the "draggable" attribute
 // Only sets the attribute if the condition is true
-func (e *a) DraggableIf(condition bool, value string) *a {
+func (e *Tag) DraggableIf(condition bool, value string) *Tag {
 	if condition {
 		e.Attribute("draggable", value)
 	}
@@ -617,14 +1295,14 @@ func (e *a) DraggableIf(condition bool, value string) *a {
 
 // Hidden sets the "hidden" attribute
 // Returns the element itself to enable method chaining
-func (e *a) Hidden(value string) *a {
+func (e *Tag) Hidden(value string) *Tag {
 	e.Attribute("hidden", value)
 	return e
 }
 
 // HiddenIf conditionally sets the "hidden" attribute
 // Only sets the attribute if the condition is true
-func (e *a) HiddenIf(condition bool, value string) *a {
+func (e *Tag) HiddenIf(condition bool, value string) *Tag {
 	if condition {
 		e.Attribute("hidden", value)
 	}
@@ -633,14 +1311,14 @@ func (e *a) HiddenIf(condition bool, value string) *a {
 
 // Lang sets the "lang" attribute
 // Returns the element itself to enable method chaining
-func (e *a) Lang(value string) *a {
+func (e *Tag) Lang(value string) *Tag {
 	e.Attribute("lang", value)
 	return e
 }
 
 // LangIf conditionally sets the "lang" attribute
 // Only sets the attribute if the condition is true
-func (e *a) LangIf(condition bool, value string) *a {
+func (e *Tag) LangIf(condition bool, value string) *Tag {
 	if condition {
 		e.Attribute("lang", value)
 	}
@@ -649,14 +1327,14 @@ func (e *a) LangIf(condition bool, value string) *a {
 
 // Spellcheck sets the "spellcheck" attribute
 // Returns the element itself to enable method chaining
-func (e *a) Spellcheck(value string) *a {
+func (e *Tag) Spellcheck(value string) *Tag {
 	e.Attribute("spellcheck", value)
 	return e
 }
 
 // SpellcheckIf conditionally sets the "spellcheck" attribute
 // Only sets the attribute if the condition is true
-func (e *a) SpellcheckIf(condition bool, value string) *a {
+func (e *Tag) SpellcheckIf(condition bool, value string) *Tag {
 	if condition {
 		e.Attribute("spellcheck", value)
 	}
@@ -665,30 +1343,37 @@ func (e *a) SpellcheckIf(condition bool, value string) *a {
 
 // Tabindex sets the "tabindex" attribute
 // Returns the element itself to enable method chaining
-func (e *a) Tabindex(value string) *a {
+func (e *Tag) Tabindex(value string) *Tag {
 	e.Attribute("tabindex", value)
 	return e
 }
 
 // TabindexIf conditionally sets the "tabindex" attribute
 // Only sets the attribute if the condition is true
-func (e *a) TabindexIf(condition bool, value string) *a {
+func (e *Tag) TabindexIf(condition bool, value string) *Tag {
 	if condition {
 		e.Attribute("tabindex", value)
 	}
 	return e
 }
 
+// TabindexInt sets the "tabindex" attribute to an integer value
+// Returns the element itself to enable method chaining
+func (e *Tag) TabindexInt(value int) *Tag {
+	e.AttributeInt("tabindex", value)
+	return e
+}
+
 // Title sets the "title" attribute
 // Returns the element itself to enable method chaining
-func (e *a) Title(value string) *a {
+func (e *Tag) Title(value string) *Tag {
 	e.Attribute("title", value)
 	return e
 }
 
 // TitleIf conditionally sets the "title" attribute
 // Only sets the attribute if the condition is true
-func (e *a) TitleIf(condition bool, value string) *a {
+func (e *Tag) TitleIf(condition bool, value string) *Tag {
 	if condition {
 		e.Attribute("title", value)
 	}
@@ -697,3229 +1382,6266 @@ func (e *a) TitleIf(condition bool, value string) *a {
 
 // Translate sets the "translate" attribute
 // Returns the element itself to enable method chaining
-func (e *a) Translate(value string) *a {
+func (e *Tag) Translate(value string) *Tag {
 	e.Attribute("translate", value)
 	return e
 }
 
 // TranslateIf conditionally sets the "translate" attribute
 // Only sets the attribute if the condition is true
-func (e *a) TranslateIf(condition bool, value string) *a {
+func (e *Tag) TranslateIf(condition bool, value string) *Tag {
 	if condition {
 		e.Attribute("translate", value)
 	}
 	return e
 }
 
-// Role sets the "role" attribute
+// Itemscope sets the boolean "itemscope" attribute, starting a microdata item
 // Returns the element itself to enable method chaining
-func (e *a) Role(value string) *a {
-	e.Attribute("role", value)
-	return e
+func (e *Tag) Itemscope() *Tag {
+	return e.BooleanAttribute("itemscope")
 }
 
-// RoleIf conditionally sets the "role" attribute
+// ItemscopeIf conditionally sets the boolean "itemscope" attribute
 // Only sets the attribute if the condition is true
-func (e *a) RoleIf(condition bool, value string) *a {
-	if condition {
-		e.Attribute("role", value)
-	}
-	return e
-}
-
-// Abbr represents the <abbr> HTML element
-type abbr struct {
-	// Embeds the base Tag to inherit core HTML element functionality
-	*Tag
-}
-
-// Abbr creates a new abbr element
-// Allows optional child nodes to be passed during creation
-func Abbr(children ...Node) *abbr {
-	return &abbr{NewTag("abbr", false, children)}
-}
-
-// Address represents the <address> HTML element
-type address struct {
-	// Embeds the base Tag to inherit core HTML element functionality
-	*Tag
-}
-
-// Address creates a new address element
-// Allows optional child nodes to be passed during creation
-func Address(children ...Node) *address {
-	return &address{NewTag("address", false, children)}
-}
-
-// Area represents the <area> HTML element
-type area struct {
-	// Embeds the base Tag to inherit core HTML element functionality
-	*Tag
+func (e *Tag) ItemscopeIf(condition bool) *Tag {
+	return e.BooleanAttributeIf(condition, "itemscope")
 }
 
-// Area creates a new area element
-// Allows optional child nodes to be passed during creation
-func Area(children ...Node) *area {
-	return &area{NewTag("area", true, children)}
-}
-
-// Alt sets the "alt" attribute
+// Itemtype sets the "itemtype" attribute
 // Returns the element itself to enable method chaining
-func (e *area) Alt(value string) *area {
-	e.Attribute("alt", value)
+func (e *Tag) Itemtype(value string) *Tag {
+	e.Attribute("itemtype", value)
 	return e
 }
 
-// AltIf conditionally sets the "alt" attribute
+// ItemtypeIf conditionally sets the "itemtype" attribute
 // Only sets the attribute if the condition is true
-func (e *area) AltIf(condition bool, value string) *area {
+func (e *Tag) ItemtypeIf(condition bool, value string) *Tag {
 	if condition {
-		e.Attribute("alt", value)
+		e.Attribute("itemtype", value)
 	}
 	return e
 }
 
-// Coords sets the "coords" attribute
+// Itemprop sets the "itemprop" attribute
 // Returns the element itself to enable method chaining
-func (e *area) Coords(value string) *area {
-	e.Attribute("coords", value)
+func (e *Tag) Itemprop(value string) *Tag {
+	e.Attribute("itemprop", value)
 	return e
 }
 
-// CoordsIf conditionally sets the "coords" attribute
+// ItempropIf conditionally sets the "itemprop" attribute
 // Only sets the attribute if the condition is true
-func (e *area) CoordsIf(condition bool, value string) *area {
+func (e *Tag) ItempropIf(condition bool, value string) *Tag {
 	if condition {
-		e.Attribute("coords", value)
+		e.Attribute("itemprop", value)
 	}
 	return e
 }
 
-// Shape sets the "shape" attribute
+// Itemid sets the "itemid" attribute
 // Returns the element itself to enable method chaining
-func (e *area) Shape(value string) *area {
-	e.Attribute("shape", value)
+func (e *Tag) Itemid(value string) *Tag {
+	e.Attribute("itemid", value)
 	return e
 }
 
-// ShapeIf conditionally sets the "shape" attribute
+// ItemidIf conditionally sets the "itemid" attribute
 // Only sets the attribute if the condition is true
-func (e *area) ShapeIf(condition bool, value string) *area {
+func (e *Tag) ItemidIf(condition bool, value string) *Tag {
 	if condition {
-		e.Attribute("shape", value)
+		e.Attribute("itemid", value)
 	}
 	return e
 }
 
-// Article represents the <article> HTML element
-type article struct {
+// A represents the <a> HTML element
+type a struct {
 	// Embeds the base Tag to inherit core HTML element functionality
 	*Tag
 }
 
-// Article creates a new article element
+// A creates a new a element
 // Allows optional child nodes to be passed during creation
-func Article(children ...Node) *article {
-	return &article{NewTag("article", false, children)}
+func A(children ...Node) *a {
+	return &a{NewTag("a", false, children)}
 }
 
-// Aside represents the <aside> HTML element
-type aside struct {
-	// Embeds the base Tag to inherit core HTML element functionality
-	*Tag
+// Class sets the "class" attribute, replacing any class set before
+// The values are normalized with Classes, so duplicates and blanks are dropped
+// Use AddClass to append to the current classes instead
+// Returns the element itself to enable method chaining
+func (e *Tag) Class(values ...string) *Tag {
+	e.Attribute("class", Classes(values...))
+	return e
 }
 
-// Aside creates a new aside element
-// Allows optional child nodes to be passed during creation
-func Aside(children ...Node) *aside {
-	return &aside{NewTag("aside", false, children)}
+// Classes normalizes a class list: every value is split on whitespace, blank
+// and duplicate tokens are dropped and the others are joined by a single space
+// in the order they first appear, e.g. Classes("btn", "", "btn  active") is
+// "btn active"
+func Classes(values ...string) string {
+	var tokens []string
+	for _, value := range values {
+		for _, token := range strings.Fields(value) {
+			if !slices.Contains(tokens, token) {
+				tokens = append(tokens, token)
+			}
+		}
+	}
+	return strings.Join(tokens, " ")
 }
 
-// Audio represents the <audio> HTML element
-type audio struct {
-	// Embeds the base Tag to inherit core HTML element functionality
-	*Tag
+// MergeClass merges an incoming class list into an existing one, the rule the
+// library uses whenever classes are added to an element, such as by AddClass
+// or the class passed through Attrs: the lists are joined by a single space,
+// blank and duplicate tokens are dropped and the existing classes come first,
+// e.g. MergeClass("card shadow", " mt-4 card") is "card shadow mt-4"
+func MergeClass(existing, incoming string) string {
+	return Classes(existing, incoming)
 }
 
-// Audio creates a new audio element
-// Allows optional child nodes to be passed during creation
-func Audio(children ...Node) *audio {
-	return &audio{NewTag("audio", false, children)}
+// ClassIf conditionally sets the "class" attribute
+// Only sets the attribute if the condition is true
+func (e *Tag) ClassIf(condition bool, value string) *Tag {
+	if condition {
+		e.Class(value)
+	}
+	return e
 }
 
-// Src sets the "src" attribute
+// ClassMap adds every class whose value is true, in sorted order
+// The classes are appended to the current "class" attribute instead of replacing it
+func (e *Tag) ClassMap(classes map[string]bool) *Tag {
+	var values []string
+	for _, class := range slices.Sorted(maps.Keys(classes)) {
+		if classes[class] {
+			values = append(values, class)
+		}
+	}
+	return e.appendClass(values)
+}
+
+// AddClass appends classes to the current "class" attribute
+// Unlike Class it keeps the classes set before, skipping the ones already present
 // Returns the element itself to enable method chaining
-func (e *audio) Src(value string) *audio {
-	e.Attribute("src", value)
-	return e
+func (e *Tag) AddClass(values ...string) *Tag {
+	return e.appendClass(values)
 }
 
-// SrcIf conditionally sets the "src" attribute
-// Only sets the attribute if the condition is true
-func (e *audio) SrcIf(condition bool, value string) *audio {
+// AddClassIf conditionally appends classes to the current "class" attribute
+// Only adds the classes if the condition is true
+func (e *Tag) AddClassIf(condition bool, values ...string) *Tag {
 	if condition {
-		e.Attribute("src", value)
+		e.appendClass(values)
 	}
 	return e
 }
 
-// Controls sets the "controls" attribute
+// appendClass appends the class tokens found in values to the current "class"
+// attribute, skipping the tokens that are already present
+func (e *Tag) appendClass(values []string) *Tag {
+	e.Attribute("class", MergeClass(e.attributes["class"], strings.Join(values, " ")))
+	return e
+}
+
+// Role sets the "role" attribute
 // Returns the element itself to enable method chaining
-func (e *audio) Controls(value string) *audio {
-	e.Attribute("controls", value)
+func (e *Tag) Role(value string) *Tag {
+	e.Attribute("role", value)
 	return e
 }
 
-// ControlsIf conditionally sets the "controls" attribute
+// RoleIf conditionally sets the "role" attribute
 // Only sets the attribute if the condition is true
-func (e *audio) ControlsIf(condition bool, value string) *audio {
+func (e *Tag) RoleIf(condition bool, value string) *Tag {
 	if condition {
-		e.Attribute("controls", value)
+		e.Attribute("role", value)
 	}
 	return e
 }
 
-// Autoplay sets the "autoplay" attribute
+// Aria sets the "aria-<name>" attribute, e.g. Aria("label", "Close")
 // Returns the element itself to enable method chaining
-func (e *audio) Autoplay(value string) *audio {
-	e.Attribute("autoplay", value)
+func (e *Tag) Aria(name, value string) *Tag {
+	e.Attribute("aria-"+name, value)
 	return e
 }
 
-// AutoplayIf conditionally sets the "autoplay" attribute
+// AriaIf conditionally sets the "aria-<name>" attribute
 // Only sets the attribute if the condition is true
-func (e *audio) AutoplayIf(condition bool, value string) *audio {
+func (e *Tag) AriaIf(condition bool, name, value string) *Tag {
 	if condition {
-		e.Attribute("autoplay", value)
+		e.Attribute("aria-"+name, value)
 	}
 	return e
 }
 
-// Loop sets the "loop" attribute
+// On sets the "on<event>" attribute, e.g. On("click", "toggle()") sets "onclick"
+// The handler is raw JavaScript: it is attribute-escaped so it cannot break out
+// of the quotes, but the caller is responsible for the safety of the script itself
 // Returns the element itself to enable method chaining
-func (e *audio) Loop(value string) *audio {
-	e.Attribute("loop", value)
+func (e *Tag) On(event, handler string) *Tag {
+	e.Attribute("on"+event, handler)
 	return e
 }
 
-// LoopIf conditionally sets the "loop" attribute
+// OnIf conditionally sets the "on<event>" attribute
 // Only sets the attribute if the condition is true
-func (e *audio) LoopIf(condition bool, value string) *audio {
+func (e *Tag) OnIf(condition bool, event, handler string) *Tag {
 	if condition {
-		e.Attribute("loop", value)
+		e.Attribute("on"+event, handler)
 	}
 	return e
 }
 
-// Muted sets the "muted" attribute
+// Href sets the "href" attribute
 // Returns the element itself to enable method chaining
-func (e *audio) Muted(value string) *audio {
-	e.Attribute("muted", value)
+func (e *a) Href(value string) *a {
+	e.Attribute("href", value)
 	return e
 }
 
-// MutedIf conditionally sets the "muted" attribute
+// HrefIf conditionally sets the "href" attribute
 // Only sets the attribute if the condition is true
-func (e *audio) MutedIf(condition bool, value string) *audio {
+func (e *a) HrefIf(condition bool, value string) *a {
 	if condition {
-		e.Attribute("muted", value)
+		e.Attribute("href", value)
 	}
 	return e
 }
 
-// Preload sets the "preload" attribute
+// Target sets the "target" attribute
 // Returns the element itself to enable method chaining
-func (e *audio) Preload(value string) *audio {
-	e.Attribute("preload", value)
+func (e *a) Target(value string) *a {
+	e.Attribute("target", value)
 	return e
 }
 
-// PreloadIf conditionally sets the "preload" attribute
+// TargetIf conditionally sets the "target" attribute
 // Only sets the attribute if the condition is true
-func (e *audio) PreloadIf(condition bool, value string) *audio {
+func (e *a) TargetIf(condition bool, value string) *a {
 	if condition {
-		e.Attribute("preload", value)
+		e.Attribute("target", value)
 	}
 	return e
 }
 
-// B represents the <b> HTML element
-type b struct {
-	// Embeds the base Tag to inherit core HTML element functionality
-	*Tag
-}
-
-// B creates a new b element
-// Allows optional child nodes to be passed during creation
-func B(children ...Node) *b {
-	return &b{NewTag("b", false, children)}
-}
-
-// Base represents the <base> HTML element
-type base struct {
-	// Embeds the base Tag to inherit core HTML element functionality
-	*Tag
-}
-
-// Base creates a new base element
-// Allows optional child nodes to be passed during creation
-func Base(children ...Node) *base {
-	return &base{NewTag("base", true, children)}
-}
-
-// Bdi represents the <bdi> HTML element
-type bdi struct {
-	// Embeds the base Tag to inherit core HTML element functionality
-	*Tag
-}
-
-// Bdi creates a new bdi element
-// Allows optional child nodes to be passed during creation
-func Bdi(children ...Node) *bdi {
-	return &bdi{NewTag("bdi", false, children)}
-}
-
-// Bdo represents the <bdo> HTML element
-type bdo struct {
-	// Embeds the base Tag to inherit core HTML element functionality
-	*Tag
-}
-
-// Bdo creates a new bdo element
-// Allows optional child nodes to be passed during creation
-func Bdo(children ...Node) *bdo {
-	return &bdo{NewTag("bdo", false, children)}
-}
-
-// Blockquote represents the <blockquote> HTML element
-type blockquote struct {
-	// Embeds the base Tag to inherit core HTML element functionality
-	*Tag
-}
-
-// Blockquote creates a new blockquote element
-// Allows optional child nodes to be passed during creation
-func Blockquote(children ...Node) *blockquote {
-	return &blockquote{NewTag("blockquote", false, children)}
-}
-
-// Cite sets the "cite" attribute
+// Download sets the "download" attribute
 // Returns the element itself to enable method chaining
-func (e *blockquote) Cite(value string) *blockquote {
-	e.Attribute("cite", value)
+func (e *a) Download(value string) *a {
+	e.Attribute("download", value)
 	return e
 }
 
-// CiteIf conditionally sets the "cite" attribute
+// DownloadIf conditionally sets the "download" attribute
 // Only sets the attribute if the condition is true
-func (e *blockquote) CiteIf(condition bool, value string) *blockquote {
+func (e *a) DownloadIf(condition bool, value string) *a {
 	if condition {
-		e.Attribute("cite", value)
+		e.Attribute("download", value)
 	}
 	return e
 }
 
-// Body represents the <body> HTML element
-type body struct {
-	// Embeds the base Tag to inherit core HTML element functionality
-	*Tag
-}
-
-// Body creates a new body element
-// Allows optional child nodes to be passed during creation
-func Body(children ...Node) *body {
-	return &body{NewTag("body", false, children)}
+// Rel sets the "rel" attribute
+// Returns the element itself to enable method chaining
+func (e *a) Rel(value string) *a {
+	e.Attribute("rel", value)
+	return e
 }
 
-// ClassIf conditionally sets the "name" attribute
+// RelIf conditionally sets the "rel" attribute
 // Only sets the attribute if the condition is true
-func (e *button) ClassIf(condition bool, value string) *button {
+func (e *a) RelIf(condition bool, value string) *a {
 	if condition {
-		e.Attribute("class", value)
+		e.Attribute("rel", value)
 	}
 	return e
 }
 
-// Br represents the <br> HTML element
-type br struct {
-	// Embeds the base Tag to inherit core HTML element functionality
-	*Tag
-}
-
-// Br creates a new br element
-// Allows optional child nodes to be passed during creation
-func Br(children ...Node) *br {
-	return &br{NewTag("br", true, children)}
-}
-
-// Button represents the <button> HTML element
-type button struct {
-	// Embeds the base Tag to inherit core HTML element functionality
-	*Tag
-}
-
-// Button creates a new button element
-// Allows optional child nodes to be passed during creation
-func Button(children ...Node) *button {
-	return &button{NewTag("button", false, children)}
-}
-
-// Value sets the "value" attribute
+// Blank opens the link in a new tab, setting "target" to "_blank" along with
+// "noopener noreferrer" in "rel" so the opened page can't reach back to this
+// one through window.opener. Other rel values already set are kept.
 // Returns the element itself to enable method chaining
-func (e *button) Value(value string) *button {
-	e.Attribute("value", value)
+func (e *a) Blank() *a {
+	e.Attribute("target", "_blank")
+	e.Attribute("rel", Classes(e.attributes["rel"], "noopener noreferrer"))
 	return e
 }
 
-// ValueIf conditionally sets the "value" attribute
-// Only sets the attribute if the condition is true
-func (e *button) ValueIf(condition bool, value string) *button {
-	if condition {
-		e.Attribute("value", value)
-	}
-	return e
+// ExternalLink creates a link to href opening in a new tab, see Blank
+func ExternalLink(href string, children ...Node) *a {
+	return A(children...).Href(href).Blank()
 }
 
 // Type sets the "type" attribute
 // Returns the element itself to enable method chaining
-func (e *button) Type(value string) *button {
+func (e *a) Type(value string) *a {
 	e.Attribute("type", value)
 	return e
 }
 
 // TypeIf conditionally sets the "type" attribute
 // Only sets the attribute if the condition is true
-func (e *button) TypeIf(condition bool, value string) *button {
+func (e *a) TypeIf(condition bool, value string) *a {
 	if condition {
 		e.Attribute("type", value)
 	}
 	return e
 }
 
-// Name sets the "name" attribute
+// Hreflang sets the "hreflang" attribute
 // Returns the element itself to enable method chaining
-func (e *button) Name(value string) *button {
-	e.Attribute("name", value)
+func (e *a) Hreflang(value string) *a {
+	e.Attribute("hreflang", value)
 	return e
 }
 
-// NameIf conditionally sets the "name" attribute
+// HreflangIf conditionally sets the "hreflang" attribute
 // Only sets the attribute if the condition is true
-func (e *button) NameIf(condition bool, value string) *button {
+func (e *a) HreflangIf(condition bool, value string) *a {
 	if condition {
-		e.Attribute("name", value)
+		e.Attribute("hreflang", value)
 	}
 	return e
 }
 
-// Disabled sets the "disabled" attribute
+// Media sets the "media" attribute
 // Returns the element itself to enable method chaining
-func (e *button) Disabled(value string) *button {
-	e.Attribute("disabled", value)
+func (e *a) Media(value string) *a {
+	e.Attribute("media", value)
 	return e
 }
 
-// DisabledIf conditionally sets the "disabled" attribute
+// MediaIf conditionally sets the "media" attribute
 // Only sets the attribute if the condition is true
-func (e *button) DisabledIf(condition bool, value string) *button {
+func (e *a) MediaIf(condition bool, value string) *a {
 	if condition {
-		e.Attribute("disabled", value)
+		e.Attribute("media", value)
 	}
 	return e
 }
 
-// Form sets the "form" attribute
+// Ping sets the "ping" attribute
 // Returns the element itself to enable method chaining
-func (e *button) Form(value string) *button {
-	e.Attribute("form", value)
+func (e *a) Ping(value string) *a {
+	e.Attribute("ping", value)
 	return e
 }
 
-// FormIf conditionally sets the "form" attribute
+// PingIf conditionally sets the "ping" attribute
 // Only sets the attribute if the condition is true
-func (e *button) FormIf(condition bool, value string) *button {
+func (e *a) PingIf(condition bool, value string) *a {
 	if condition {
-		e.Attribute("form", value)
+		e.Attribute("ping", value)
 	}
 	return e
 }
 
-// Formaction sets the "formaction" attribute
+// Referrerpolicy sets the "referrerpolicy" attribute
 // Returns the element itself to enable method chaining
-func (e *button) Formaction(value string) *button {
-	e.Attribute("formaction", value)
+func (e *a) Referrerpolicy(value string) *a {
+	e.Attribute("referrerpolicy", value)
 	return e
 }
 
-// FormactionIf conditionally sets the "formaction" attribute
+// ReferrerpolicyIf conditionally sets the "referrerpolicy" attribute
 // Only sets the attribute if the condition is true
-func (e *button) FormactionIf(condition bool, value string) *button {
+func (e *a) ReferrerpolicyIf(condition bool, value string) *a {
 	if condition {
-		e.Attribute("formaction", value)
+		e.Attribute("referrerpolicy", value)
 	}
 	return e
 }
 
-// Formmethod sets the "formmethod" attribute
+// Style sets the "style" attribute
 // Returns the element itself to enable method chaining
-func (e *button) Formmethod(value string) *button {
-	e.Attribute("formmethod", value)
+func (e *a) Style(value string) *a {
+	e.Attribute("style", value)
 	return e
 }
 
-// FormmethodIf conditionally sets the "formmethod" attribute
+// StyleIf conditionally sets the "style" attribute
 // Only sets the attribute if the condition is true
-func (e *button) FormmethodIf(condition bool, value string) *button {
+func (e *a) StyleIf(condition bool, value string) *a {
 	if condition {
-		e.Attribute("formmethod", value)
+		e.Attribute("style", value)
 	}
 	return e
 }
 
-// Formenctype sets the "formenctype" attribute
+// ID sets the "id" attribute
 // Returns the element itself to enable method chaining
-func (e *button) Formenctype(value string) *button {
-	e.Attribute("formenctype", value)
+func (e *a) ID(value string) *a {
+	e.Attribute("id", value)
 	return e
 }
 
-// FormenctypeIf conditionally sets the "formenctype" attribute
+// IDIf conditionally sets the "id" attribute
 // Only sets the attribute if the condition is true
-func (e *button) FormenctypeIf(condition bool, value string) *button {
+func (e *a) IDIf(condition bool, value string) *a {
 	if condition {
-		e.Attribute("formenctype", value)
+		e.Attribute("id", value)
 	}
 	return e
 }
 
-// Formtarget sets the "formtarget" attribute
+// Accesskey sets the "accesskey" attribute
 // Returns the element itself to enable method chaining
-func (e *button) Formtarget(value string) *button {
-	e.Attribute("formtarget", value)
+func (e *a) Accesskey(value string) *a {
+	e.Attribute("accesskey", value)
 	return e
 }
 
-// FormtargetIf conditionally sets the "formtarget" attribute
+// AccesskeyIf conditionally sets the "accesskey" attribute
 // Only sets the attribute if the condition is true
-func (e *button) FormtargetIf(condition bool, value string) *button {
+func (e *a) AccesskeyIf(condition bool, value string) *a {
 	if condition {
-		e.Attribute("formtarget", value)
+		e.Attribute("accesskey", value)
 	}
 	return e
 }
 
-// Formnovalidate sets the "formnovalidate" attribute
+// Contenteditable sets the "contenteditable" attribute
 // Returns the element itself to enable method chaining
-func (e *button) Formnovalidate(value string) *button {
-	e.Attribute("formnovalidate", value)
+func (e *a) Contenteditable(value string) *a {
+	e.Attribute("contenteditable", value)
 	return e
 }
 
-// FormnovalidateIf conditionally sets the "formnovalidate" attribute
+// ContenteditableIf conditionally sets the "contenteditable" attribute
 // Only sets the attribute if the condition is true
-func (e *button) FormnovalidateIf(condition bool, value string) *button {
+func (e *a) ContenteditableIf(condition bool, value string) *a {
 	if condition {
-		e.Attribute("formnovalidate", value)
+		e.Attribute("contenteditable", value)
 	}
 	return e
 }
 
-// Autofocus sets the "autofocus" attribute
+// Dir sets the "dir" attribute
 // Returns the element itself to enable method chaining
-func (e *button) Autofocus(value string) *button {
-	e.Attribute("autofocus", value)
+func (e *a) Dir(value string) *a {
+	e.Attribute("dir", value)
 	return e
 }
 
-// AutofocusIf conditionally sets the "autofocus" attribute
+// DirIf conditionally sets the "dir" attribute
 // Only sets the attribute if the condition is true
-func (e *button) AutofocusIf(condition bool, value string) *button {
+func (e *a) DirIf(condition bool, value string) *a {
 	if condition {
-		e.Attribute("autofocus", value)
+		e.Attribute("dir", value)
 	}
 	return e
 }
 
-// Canvas represents the <canvas> HTML element
-type canvas struct {
-	// Embeds the base Tag to inherit core HTML element functionality
-	*Tag
-}
-
-// Canvas creates a new canvas element
-// Allows optional child nodes to be passed during creation
-func Canvas(children ...Node) *canvas {
-	return &canvas{NewTag("canvas", false, children)}
-}
-
-// Width sets the "width" attribute
+// Draggable sets the "draggable" attribute
 // Returns the element itself to enable method chaining
-func (e *canvas) Width(value string) *canvas {
-	e.Attribute("width", value)
+func (e *a) Draggable(value string) *a {
+	e.Attribute("draggable", value)
 	return e
 }
 
-// WidthIf conditionally sets the "width" attribute
+// DraggableIf conditionally sets the "draggable" attribute
 // Only sets the attribute if the condition is true
-func (e *canvas) WidthIf(condition bool, value string) *canvas {
+func (e *a) DraggableIf(condition bool, value string) *a {
 	if condition {
-		e.Attribute("width", value)
+		e.Attribute("draggable", value)
 	}
 	return e
 }
 
-// Height sets the "height" attribute
+// Hidden sets the "hidden" attribute
 // Returns the element itself to enable method chaining
-func (e *canvas) Height(value string) *canvas {
-	e.Attribute("height", value)
+func (e *a) Hidden(value string) *a {
+	e.Attribute("hidden", value)
 	return e
 }
 
-// HeightIf conditionally sets the "height" attribute
+// HiddenIf conditionally sets the "hidden" attribute
 // Only sets the attribute if the condition is true
-func (e *canvas) HeightIf(condition bool, value string) *canvas {
+func (e *a) HiddenIf(condition bool, value string) *a {
 	if condition {
-		e.Attribute("height", value)
+		e.Attribute("hidden", value)
 	}
 	return e
 }
 
-// Caption represents the <caption> HTML element
-type caption struct {
-	// Embeds the base Tag to inherit core HTML element functionality
-	*Tag
+// Lang sets the "lang" attribute
+// Returns the element itself to enable method chaining
+func (e *a) Lang(value string) *a {
+	e.Attribute("lang", value)
+	return e
 }
 
-// Caption creates a new caption element
-// Allows optional child nodes to be passed during creation
-func Caption(children ...Node) *caption {
-	return &caption{NewTag("caption", false, children)}
+// LangIf conditionally sets the "lang" attribute
+// Only sets the attribute if the condition is true
+func (e *a) LangIf(condition bool, value string) *a {
+	if condition {
+		e.Attribute("lang", value)
+	}
+	return e
 }
 
-// Cite represents the <cite> HTML element
-type cite struct {
-	// Embeds the base Tag to inherit core HTML element functionality
-	*Tag
+// Spellcheck sets the "spellcheck" attribute
+// Returns the element itself to enable method chaining
+func (e *a) Spellcheck(value string) *a {
+	e.Attribute("spellcheck", value)
+	return e
 }
 
-// Cite creates a new cite element
-// Allows optional child nodes to be passed during creation
-func Cite(children ...Node) *cite {
-	return &cite{NewTag("cite", false, children)}
+// SpellcheckIf conditionally sets the "spellcheck" attribute
+// Only sets the attribute if the condition is true
+func (e *a) SpellcheckIf(condition bool, value string) *a {
+	if condition {
+		e.Attribute("spellcheck", value)
+	}
+	return e
 }
 
-// Code represents the <code> HTML element
-type code struct {
-	// Embeds the base Tag to inherit core HTML element functionality
-	*Tag
+// Tabindex sets the "tabindex" attribute
+// Returns the element itself to enable method chaining
+func (e *a) Tabindex(value string) *a {
+	e.Attribute("tabindex", value)
+	return e
 }
 
-// Code creates a new code element
-// Allows optional child nodes to be passed during creation
-func Code(children ...Node) *code {
-	return &code{NewTag("code", false, children)}
+// TabindexIf conditionally sets the "tabindex" attribute
+// Only sets the attribute if the condition is true
+func (e *a) TabindexIf(condition bool, value string) *a {
+	if condition {
+		e.Attribute("tabindex", value)
+	}
+	return e
 }
 
-// Col represents the <col> HTML element
-type col struct {
-	// Embeds the base Tag to inherit core HTML element functionality
-	*Tag
+// Title sets the "title" attribute
+// Returns the element itself to enable method chaining
+func (e *a) Title(value string) *a {
+	e.Attribute("title", value)
+	return e
 }
 
-// Col creates a new col element
-// Allows optional child nodes to be passed during creation
-func Col(children ...Node) *col {
-	return &col{NewTag("col", true, children)}
+// TitleIf conditionally sets the "title" attribute
+// Only sets the attribute if the condition is true
+func (e *a) TitleIf(condition bool, value string) *a {
+	if condition {
+		e.Attribute("title", value)
+	}
+	return e
 }
 
-// Span sets the "span" attribute
+// Translate sets the "translate" attribute
 // Returns the element itself to enable method chaining
-func (e *col) Span(value string) *col {
-	e.Attribute("span", value)
+func (e *a) Translate(value string) *a {
+	e.Attribute("translate", value)
 	return e
 }
 
-// SpanIf conditionally sets the "span" attribute
+// TranslateIf conditionally sets the "translate" attribute
 // Only sets the attribute if the condition is true
-func (e *col) SpanIf(condition bool, value string) *col {
+func (e *a) TranslateIf(condition bool, value string) *a {
 	if condition {
-		e.Attribute("span", value)
+		e.Attribute("translate", value)
 	}
 	return e
 }
 
-// Colgroup represents the <colgroup> HTML element
-type colgroup struct {
+// Abbr represents the <abbr> HTML element
+type abbr struct {
 	// Embeds the base Tag to inherit core HTML element functionality
 	*Tag
 }
 
-// Colgroup creates a new colgroup element
+// Abbr creates a new abbr element
 // Allows optional child nodes to be passed during creation
-func Colgroup(children ...Node) *colgroup {
-	return &colgroup{NewTag("colgroup", false, children)}
+func Abbr(children ...Node) *abbr {
+	return &abbr{NewTag("abbr", false, children)}
 }
 
-// Data represents the <data> HTML element
-type data struct {
+// Address represents the <address> HTML element
+type address struct {
 	// Embeds the base Tag to inherit core HTML element functionality
 	*Tag
 }
 
-// Data creates a new data element
+// Address creates a new address element
 // Allows optional child nodes to be passed during creation
-func Data(children ...Node) *data {
-	return &data{NewTag("data", false, children)}
+func Address(children ...Node) *address {
+	return &address{NewTag("address", false, children)}
 }
 
-// Datalist represents the <datalist> HTML element
-type datalist struct {
+// Area represents the <area> HTML element
+type area struct {
 	// Embeds the base Tag to inherit core HTML element functionality
 	*Tag
 }
 
-// Datalist creates a new datalist element
-// Allows optional child nodes to be passed during creation
-func Datalist(children ...Node) *datalist {
-	return &datalist{NewTag("datalist", false, children)}
-}
-
-// Dd represents the <dd> HTML element
-type dd struct {
-	// Embeds the base Tag to inherit core HTML element functionality
-	*Tag
+// Area creates a new area element
+// Takes no children since a void element can't have any
+func Area() *area {
+	return &area{NewTag("area", voidElements["area"], nil)}
 }
 
-// Dd creates a new dd element
-// Allows optional child nodes to be passed during creation
-func Dd(children ...Node) *dd {
-	return &dd{NewTag("dd", false, children)}
+// Alt sets the "alt" attribute
+// Returns the element itself to enable method chaining
+func (e *area) Alt(value string) *area {
+	e.Attribute("alt", value)
+	return e
 }
 
-// Del represents the <del> HTML element
-type del struct {
-	// Embeds the base Tag to inherit core HTML element functionality
-	*Tag
+// AltIf conditionally sets the "alt" attribute
+// Only sets the attribute if the condition is true
+func (e *area) AltIf(condition bool, value string) *area {
+	if condition {
+		e.Attribute("alt", value)
+	}
+	return e
 }
 
-// Del creates a new del element
-// Allows optional child nodes to be passed during creation
+// Coords sets the "coords" attribute
+// Returns the element itself to enable method chaining
+func (e *area) Coords(value string) *area {
+	e.Attribute("coords", value)
+	return e
+}
+
+// CoordsIf conditionally sets the "coords" attribute
+// Only sets the attribute if the condition is true
+func (e *area) CoordsIf(condition bool, value string) *area {
+	if condition {
+		e.Attribute("coords", value)
+	}
+	return e
+}
+
+// Shape sets the "shape" attribute
+// Returns the element itself to enable method chaining
+func (e *area) Shape(value string) *area {
+	e.Attribute("shape", value)
+	return e
+}
+
+// ShapeIf conditionally sets the "shape" attribute
+// Only sets the attribute if the condition is true
+func (e *area) ShapeIf(condition bool, value string) *area {
+	if condition {
+		e.Attribute("shape", value)
+	}
+	return e
+}
+
+// Article represents the <article> HTML element
+type article struct {
+	// Embeds the base Tag to inherit core HTML element functionality
+	*Tag
+}
+
+// Article creates a new article element
+// Allows optional child nodes to be passed during creation
+func Article(children ...Node) *article {
+	return &article{NewTag("article", false, children)}
+}
+
+// Aside represents the <aside> HTML element
+type aside struct {
+	// Embeds the base Tag to inherit core HTML element functionality
+	*Tag
+}
+
+// Aside creates a new aside element
+// Allows optional child nodes to be passed during creation
+func Aside(children ...Node) *aside {
+	return &aside{NewTag("aside", false, children)}
+}
+
+// Audio represents the <audio> HTML element
+type audio struct {
+	// Embeds the base Tag to inherit core HTML element functionality
+	*Tag
+}
+
+// Audio creates a new audio element
+// Allows optional child nodes to be passed during creation
+func Audio(children ...Node) *audio {
+	return &audio{NewTag("audio", false, children)}
+}
+
+// Src sets the "src" attribute
+// Returns the element itself to enable method chaining
+func (e *audio) Src(value string) *audio {
+	e.Attribute("src", value)
+	return e
+}
+
+// SrcIf conditionally sets the "src" attribute
+// Only sets the attribute if the condition is true
+func (e *audio) SrcIf(condition bool, value string) *audio {
+	if condition {
+		e.Attribute("src", value)
+	}
+	return e
+}
+
+// Controls sets the "controls" attribute
+// Returns the element itself to enable method chaining
+func (e *audio) Controls(value string) *audio {
+	e.Attribute("controls", value)
+	return e
+}
+
+// ControlsIf conditionally sets the "controls" attribute
+// Only sets the attribute if the condition is true
+func (e *audio) ControlsIf(condition bool, value string) *audio {
+	if condition {
+		e.Attribute("controls", value)
+	}
+	return e
+}
+
+// Autoplay sets the "autoplay" attribute
+// Returns the element itself to enable method chaining
+func (e *audio) Autoplay(value string) *audio {
+	e.Attribute("autoplay", value)
+	return e
+}
+
+// AutoplayIf conditionally sets the "autoplay" attribute
+// Only sets the attribute if the condition is true
+func (e *audio) AutoplayIf(condition bool, value string) *audio {
+	if condition {
+		e.Attribute("autoplay", value)
+	}
+	return e
+}
+
+// Loop sets the "loop" attribute
+// Returns the element itself to enable method chaining
+func (e *audio) Loop(value string) *audio {
+	e.Attribute("loop", value)
+	return e
+}
+
+// LoopIf conditionally sets the "loop" attribute
+// Only sets the attribute if the condition is true
+func (e *audio) LoopIf(condition bool, value string) *audio {
+	if condition {
+		e.Attribute("loop", value)
+	}
+	return e
+}
+
+// Muted sets the "muted" attribute
+// Returns the element itself to enable method chaining
+func (e *audio) Muted(value string) *audio {
+	e.Attribute("muted", value)
+	return e
+}
+
+// MutedIf conditionally sets the "muted" attribute
+// Only sets the attribute if the condition is true
+func (e *audio) MutedIf(condition bool, value string) *audio {
+	if condition {
+		e.Attribute("muted", value)
+	}
+	return e
+}
+
+// Preload sets the "preload" attribute
+// Returns the element itself to enable method chaining
+func (e *audio) Preload(value string) *audio {
+	e.Attribute("preload", value)
+	return e
+}
+
+// PreloadIf conditionally sets the "preload" attribute
+// Only sets the attribute if the condition is true
+func (e *audio) PreloadIf(condition bool, value string) *audio {
+	if condition {
+		e.Attribute("preload", value)
+	}
+	return e
+}
+
+// B represents the <b> HTML element
+type b struct {
+	// Embeds the base Tag to inherit core HTML element functionality
+	*Tag
+}
+
+// B creates a new b element
+// Allows optional child nodes to be passed during creation
+func B(children ...Node) *b {
+	return &b{NewTag("b", false, children)}
+}
+
+// Base represents the <base> HTML element
+type base struct {
+	// Embeds the base Tag to inherit core HTML element functionality
+	*Tag
+}
+
+// Base creates a new base element
+// Takes no children since a void element can't have any
+func Base() *base {
+	return &base{NewTag("base", voidElements["base"], nil)}
+}
+
+// Bdi represents the <bdi> HTML element
+type bdi struct {
+	// Embeds the base Tag to inherit core HTML element functionality
+	*Tag
+}
+
+// Bdi creates a new bdi element
+// Allows optional child nodes to be passed during creation
+func Bdi(children ...Node) *bdi {
+	return &bdi{NewTag("bdi", false, children)}
+}
+
+// Bdo represents the <bdo> HTML element
+type bdo struct {
+	// Embeds the base Tag to inherit core HTML element functionality
+	*Tag
+}
+
+// Bdo creates a new bdo element
+// Allows optional child nodes to be passed during creation
+func Bdo(children ...Node) *bdo {
+	return &bdo{NewTag("bdo", false, children)}
+}
+
+// Blockquote represents the <blockquote> HTML element
+type blockquote struct {
+	// Embeds the base Tag to inherit core HTML element functionality
+	*Tag
+}
+
+// Blockquote creates a new blockquote element
+// Allows optional child nodes to be passed during creation
+func Blockquote(children ...Node) *blockquote {
+	return &blockquote{NewTag("blockquote", false, children)}
+}
+
+// Cite sets the "cite" attribute
+// Returns the element itself to enable method chaining
+func (e *blockquote) Cite(value string) *blockquote {
+	e.Attribute("cite", value)
+	return e
+}
+
+// CiteIf conditionally sets the "cite" attribute
+// Only sets the attribute if the condition is true
+func (e *blockquote) CiteIf(condition bool, value string) *blockquote {
+	if condition {
+		e.Attribute("cite", value)
+	}
+	return e
+}
+
+// Body represents the <body> HTML element
+type body struct {
+	// Embeds the base Tag to inherit core HTML element functionality
+	*Tag
+}
+
+// Body creates a new body element
+// Allows optional child nodes to be passed during creation
+func Body(children ...Node) *body {
+	return &body{NewTag("body", false, children)}
+}
+
+// ClassIf conditionally sets the "class" attribute
+// Only sets the attribute if the condition is true
+func (e *button) ClassIf(condition bool, value string) *button {
+	if condition {
+		e.Class(value)
+	}
+	return e
+}
+
+// Br represents the <br> HTML element
+type br struct {
+	// Embeds the base Tag to inherit core HTML element functionality
+	*Tag
+}
+
+// Br creates a new br element
+// Takes no children since a void element can't have any
+func Br() *br {
+	return &br{NewTag("br", voidElements["br"], nil)}
+}
+
+// Button represents the <button> HTML element
+type button struct {
+	// Embeds the base Tag to inherit core HTML element functionality
+	*Tag
+}
+
+// Button creates a new button element
+// Allows optional child nodes to be passed during creation
+func Button(children ...Node) *button {
+	return &button{NewTag("button", false, children)}
+}
+
+// Value sets the "value" attribute
+// Returns the element itself to enable method chaining
+func (e *button) Value(value string) *button {
+	e.Attribute("value", value)
+	return e
+}
+
+// ValueIf conditionally sets the "value" attribute
+// Only sets the attribute if the condition is true
+func (e *button) ValueIf(condition bool, value string) *button {
+	if condition {
+		e.Attribute("value", value)
+	}
+	return e
+}
+
+// Type sets the "type" attribute
+// Returns the element itself to enable method chaining
+func (e *button) Type(value string) *button {
+	e.Attribute("type", value)
+	return e
+}
+
+// TypeIf conditionally sets the "type" attribute
+// Only sets the attribute if the condition is true
+func (e *button) TypeIf(condition bool, value string) *button {
+	if condition {
+		e.Attribute("type", value)
+	}
+	return e
+}
+
+// Name sets the "name" attribute
+// Returns the element itself to enable method chaining
+func (e *button) Name(value string) *button {
+	e.Attribute("name", value)
+	return e
+}
+
+// NameIf conditionally sets the "name" attribute
+// Only sets the attribute if the condition is true
+func (e *button) NameIf(condition bool, value string) *button {
+	if condition {
+		e.Attribute("name", value)
+	}
+	return e
+}
+
+// Disabled sets the "disabled" attribute
+// Returns the element itself to enable method chaining
+func (e *button) Disabled(value string) *button {
+	e.Attribute("disabled", value)
+	return e
+}
+
+// DisabledIf conditionally sets the "disabled" attribute
+// Only sets the attribute if the condition is true
+func (e *button) DisabledIf(condition bool, value string) *button {
+	if condition {
+		e.Attribute("disabled", value)
+	}
+	return e
+}
+
+// Form sets the "form" attribute
+// Returns the element itself to enable method chaining
+func (e *button) Form(value string) *button {
+	e.Attribute("form", value)
+	return e
+}
+
+// FormIf conditionally sets the "form" attribute
+// Only sets the attribute if the condition is true
+func (e *button) FormIf(condition bool, value string) *button {
+	if condition {
+		e.Attribute("form", value)
+	}
+	return e
+}
+
+// Formaction sets the "formaction" attribute
+// Returns the element itself to enable method chaining
+func (e *button) Formaction(value string) *button {
+	e.Attribute("formaction", value)
+	return e
+}
+
+// FormactionIf conditionally sets the "formaction" attribute
+// Only sets the attribute if the condition is true
+func (e *button) FormactionIf(condition bool, value string) *button {
+	if condition {
+		e.Attribute("formaction", value)
+	}
+	return e
+}
+
+// Formmethod sets the "formmethod" attribute
+// Returns the element itself to enable method chaining
+func (e *button) Formmethod(value string) *button {
+	e.Attribute("formmethod", value)
+	return e
+}
+
+// FormmethodIf conditionally sets the "formmethod" attribute
+// Only sets the attribute if the condition is true
+func (e *button) FormmethodIf(condition bool, value string) *button {
+	if condition {
+		e.Attribute("formmethod", value)
+	}
+	return e
+}
+
+// Formenctype sets the "formenctype" attribute
+// Returns the element itself to enable method chaining
+func (e *button) Formenctype(value string) *button {
+	e.Attribute("formenctype", value)
+	return e
+}
+
+// FormenctypeIf conditionally sets the "formenctype" attribute
+// Only sets the attribute if the condition is true
+func (e *button) FormenctypeIf(condition bool, value string) *button {
+	if condition {
+		e.Attribute("formenctype", value)
+	}
+	return e
+}
+
+// Formtarget sets the "formtarget" attribute
+// Returns the element itself to enable method chaining
+func (e *button) Formtarget(value string) *button {
+	e.Attribute("formtarget", value)
+	return e
+}
+
+// FormtargetIf conditionally sets the "formtarget" attribute
+// Only sets the attribute if the condition is true
+func (e *button) FormtargetIf(condition bool, value string) *button {
+	if condition {
+		e.Attribute("formtarget", value)
+	}
+	return e
+}
+
+// Formnovalidate sets the "formnovalidate" attribute
+// Returns the element itself to enable method chaining
+func (e *button) Formnovalidate(value string) *button {
+	e.Attribute("formnovalidate", value)
+	return e
+}
+
+// FormnovalidateIf conditionally sets the "formnovalidate" attribute
+// Only sets the attribute if the condition is true
+func (e *button) FormnovalidateIf(condition bool, value string) *button {
+	if condition {
+		e.Attribute("formnovalidate", value)
+	}
+	return e
+}
+
+// Autofocus sets the "autofocus" attribute
+// Returns the element itself to enable method chaining
+func (e *button) Autofocus(value string) *button {
+	e.Attribute("autofocus", value)
+	return e
+}
+
+// AutofocusIf conditionally sets the "autofocus" attribute
+// Only sets the attribute if the condition is true
+func (e *button) AutofocusIf(condition bool, value string) *button {
+	if condition {
+		e.Attribute("autofocus", value)
+	}
+	return e
+}
+
+// Canvas represents the <canvas> HTML element
+type canvas struct {
+	// Embeds the base Tag to inherit core HTML element functionality
+	*Tag
+}
+
+// Canvas creates a new canvas element
+// Allows optional child nodes to be passed during creation
+func Canvas(children ...Node) *canvas {
+	return &canvas{NewTag("canvas", false, children)}
+}
+
+// Width sets the "width" attribute
+// Returns the element itself to enable method chaining
+func (e *canvas) Width(value string) *canvas {
+	e.Attribute("width", value)
+	return e
+}
+
+// WidthIf conditionally sets the "width" attribute
+// Only sets the attribute if the condition is true
+func (e *canvas) WidthIf(condition bool, value string) *canvas {
+	if condition {
+		e.Attribute("width", value)
+	}
+	return e
+}
+
+// WidthInt sets the "width" attribute to an integer value
+// Returns the element itself to enable method chaining
+func (e *canvas) WidthInt(value int) *canvas {
+	e.AttributeInt("width", value)
+	return e
+}
+
+// Height sets the "height" attribute
+// Returns the element itself to enable method chaining
+func (e *canvas) Height(value string) *canvas {
+	e.Attribute("height", value)
+	return e
+}
+
+// HeightIf conditionally sets the "height" attribute
+// Only sets the attribute if the condition is true
+func (e *canvas) HeightIf(condition bool, value string) *canvas {
+	if condition {
+		e.Attribute("height", value)
+	}
+	return e
+}
+
+// HeightInt sets the "height" attribute to an integer value
+// Returns the element itself to enable method chaining
+func (e *canvas) HeightInt(value int) *canvas {
+	e.AttributeInt("height", value)
+	return e
+}
+
+// Caption represents the <caption> HTML element
+type caption struct {
+	// Embeds the base Tag to inherit core HTML element functionality
+	*Tag
+}
+
+// Caption creates a new caption element
+// Allows optional child nodes to be passed during creation
+func Caption(children ...Node) *caption {
+	return &caption{NewTag("caption", false, children)}
+}
+
+// Cite represents the <cite> HTML element
+type cite struct {
+	// Embeds the base Tag to inherit core HTML element functionality
+	*Tag
+}
+
+// Cite creates a new cite element
+// Allows optional child nodes to be passed during creation
+func Cite(children ...Node) *cite {
+	return &cite{NewTag("cite", false, children)}
+}
+
+// Code represents the <code> HTML element
+type code struct {
+	// Embeds the base Tag to inherit core HTML element functionality
+	*Tag
+}
+
+// Code creates a new code element
+// Allows optional child nodes to be passed during creation
+func Code(children ...Node) *code {
+	return &code{NewTag("code", false, children)}
+}
+
+// Col represents the <col> HTML element
+type col struct {
+	// Embeds the base Tag to inherit core HTML element functionality
+	*Tag
+}
+
+// Col creates a new col element
+// Takes no children since a void element can't have any
+func Col() *col {
+	return &col{NewTag("col", voidElements["col"], nil)}
+}
+
+// Span sets the "span" attribute
+// Returns the element itself to enable method chaining
+func (e *col) Span(value string) *col {
+	e.Attribute("span", value)
+	return e
+}
+
+// SpanIf conditionally sets the "span" attribute
+// Only sets the attribute if the condition is true
+func (e *col) SpanIf(condition bool, value string) *col {
+	if condition {
+		e.Attribute("span", value)
+	}
+	return e
+}
+
+// Colgroup represents the <colgroup> HTML element
+type colgroup struct {
+	// Embeds the base Tag to inherit core HTML element functionality
+	*Tag
+}
+
+// Colgroup creates a new colgroup element
+// Allows optional child nodes to be passed during creation
+func Colgroup(children ...Node) *colgroup {
+	return &colgroup{NewTag("colgroup", false, children)}
+}
+
+// Data represents the <data> HTML element
+type data struct {
+	// Embeds the base Tag to inherit core HTML element functionality
+	*Tag
+}
+
+// Data creates a new data element
+// Allows optional child nodes to be passed during creation
+func Data(children ...Node) *data {
+	return &data{NewTag("data", false, children)}
+}
+
+// Datalist represents the <datalist> HTML element
+type datalist struct {
+	// Embeds the base Tag to inherit core HTML element functionality
+	*Tag
+}
+
+// Datalist creates a new datalist element
+// Allows optional child nodes to be passed during creation
+func Datalist(children ...Node) *datalist {
+	return &datalist{NewTag("datalist", false, children)}
+}
+
+// Dd represents the <dd> HTML element
+type dd struct {
+	// Embeds the base Tag to inherit core HTML element functionality
+	*Tag
+}
+
+// Dd creates a new dd element
+// Allows optional child nodes to be passed during creation
+func Dd(children ...Node) *dd {
+	return &dd{NewTag("dd", false, children)}
+}
+
+// Del represents the <del> HTML element
+type del struct {
+	// Embeds the base Tag to inherit core HTML element functionality
+	*Tag
+}
+
+// Del creates a new del element
+// Allows optional child nodes to be passed during creation
 func Del(children ...Node) *del {
 	return &del{NewTag("del", false, children)}
 }
 
-// Details represents the <details> HTML element
-type details struct {
+// Details represents the <details> HTML element
+type details struct {
+	// Embeds the base Tag to inherit core HTML element functionality
+	*Tag
+}
+
+// Details creates a new details element
+// Allows optional child nodes to be passed during creation
+func Details(children ...Node) *details {
+	return &details{NewTag("details", false, children)}
+}
+
+// Open sets the boolean "open" attribute, showing the details expanded
+// Returns the element itself to enable method chaining
+func (e *details) Open() *details {
+	e.BooleanAttribute("open")
+	return e
+}
+
+// OpenIf conditionally sets the boolean "open" attribute
+// Only sets the attribute if the condition is true
+func (e *details) OpenIf(condition bool) *details {
+	e.BooleanAttributeIf(condition, "open")
+	return e
+}
+
+// Dfn represents the <dfn> HTML element
+type dfn struct {
+	// Embeds the base Tag to inherit core HTML element functionality
+	*Tag
+}
+
+// Dfn creates a new dfn element
+// Allows optional child nodes to be passed during creation
+func Dfn(children ...Node) *dfn {
+	return &dfn{NewTag("dfn", false, children)}
+}
+
+// Dialog represents the <dialog> HTML element
+type dialog struct {
+	// Embeds the base Tag to inherit core HTML element functionality
+	*Tag
+}
+
+// Dialog creates a new dialog element
+// Allows optional child nodes to be passed during creation
+func Dialog(children ...Node) *dialog {
+	return &dialog{NewTag("dialog", false, children)}
+}
+
+// Open sets the boolean "open" attribute, showing the dialog as non-modal
+// Returns the element itself to enable method chaining
+func (e *dialog) Open() *dialog {
+	e.BooleanAttribute("open")
+	return e
+}
+
+// OpenIf conditionally sets the boolean "open" attribute
+// Only sets the attribute if the condition is true
+func (e *dialog) OpenIf(condition bool) *dialog {
+	e.BooleanAttributeIf(condition, "open")
+	return e
+}
+
+// Returnvalue sets the "returnvalue" attribute
+// Returns the element itself to enable method chaining
+func (e *dialog) Returnvalue(value string) *dialog {
+	e.Attribute("returnvalue", value)
+	return e
+}
+
+// ReturnvalueIf conditionally sets the "returnvalue" attribute
+// Only sets the attribute if the condition is true
+func (e *dialog) ReturnvalueIf(condition bool, value string) *dialog {
+	if condition {
+		e.Attribute("returnvalue", value)
+	}
+	return e
+}
+
+// Div represents the <div> HTML element
+type div struct {
+	// Embeds the base Tag to inherit core HTML element functionality
+	*Tag
+}
+
+// Div creates a new div element
+// Allows optional child nodes to be passed during creation
+func Div(children ...Node) *div {
+	return &div{NewTag("div", false, children)}
+}
+
+// Dl represents the <dl> HTML element
+type dl struct {
+	// Embeds the base Tag to inherit core HTML element functionality
+	*Tag
+}
+
+// Dl creates a new dl element
+// Allows optional child nodes to be passed during creation
+func Dl(children ...Node) *dl {
+	return &dl{NewTag("dl", false, children)}
+}
+
+// Dt represents the <dt> HTML element
+type dt struct {
+	// Embeds the base Tag to inherit core HTML element functionality
+	*Tag
+}
+
+// Dt creates a new dt element
+// Allows optional child nodes to be passed during creation
+func Dt(children ...Node) *dt {
+	return &dt{NewTag("dt", false, children)}
+}
+
+// Em represents the <em> HTML element
+type em struct {
+	// Embeds the base Tag to inherit core HTML element functionality
+	*Tag
+}
+
+// Em creates a new em element
+// Allows optional child nodes to be passed during creation
+func Em(children ...Node) *em {
+	return &em{NewTag("em", false, children)}
+}
+
+// Embed represents the <embed> HTML element
+type embed struct {
+	// Embeds the base Tag to inherit core HTML element functionality
+	*Tag
+}
+
+// Embed creates a new embed element
+// Takes no children since a void element can't have any
+func Embed() *embed {
+	return &embed{NewTag("embed", voidElements["embed"], nil)}
+}
+
+// Fieldset represents the <fieldset> HTML element
+type fieldset struct {
+	// Embeds the base Tag to inherit core HTML element functionality
+	*Tag
+}
+
+// Fieldset creates a new fieldset element
+// Allows optional child nodes to be passed during creation
+func Fieldset(children ...Node) *fieldset {
+	return &fieldset{NewTag("fieldset", false, children)}
+}
+
+// Figcaption represents the <figcaption> HTML element
+type figcaption struct {
+	// Embeds the base Tag to inherit core HTML element functionality
+	*Tag
+}
+
+// Figcaption creates a new figcaption element
+// Allows optional child nodes to be passed during creation
+func Figcaption(children ...Node) *figcaption {
+	return &figcaption{NewTag("figcaption", false, children)}
+}
+
+// Figure represents the <figure> HTML element
+type figure struct {
+	// Embeds the base Tag to inherit core HTML element functionality
+	*Tag
+}
+
+// Figure creates a new figure element
+// Allows optional child nodes to be passed during creation
+func Figure(children ...Node) *figure {
+	return &figure{NewTag("figure", false, children)}
+}
+
+// Footer represents the <footer> HTML element
+type footer struct {
+	// Embeds the base Tag to inherit core HTML element functionality
+	*Tag
+}
+
+// Footer creates a new footer element
+// Allows optional child nodes to be passed during creation
+func Footer(children ...Node) *footer {
+	return &footer{NewTag("footer", false, children)}
+}
+
+// Form represents the <form> HTML element
+type form struct {
+	// Embeds the base Tag to inherit core HTML element functionality
+	*Tag
+}
+
+// Form creates a new form element
+// Allows optional child nodes to be passed during creation
+func Form(children ...Node) *form {
+	return &form{NewTag("form", false, children)}
+}
+
+// Id sets the "id" attribute
+// Returns the element itself to enable method chaining
+func (e *form) Id(value string) *form {
+	e.Attribute("id", value)
+	return e
+}
+
+// IdIf conditionally sets the "id" attribute
+// Only sets the attribute if the condition is true
+func (e *form) IdIf(condition bool, value string) *form {
+	if condition {
+		e.Attribute("id", value)
+	}
+	return e
+}
+
+// Action sets the "action" attribute
+// Returns the element itself to enable method chaining
+func (e *form) Action(value string) *form {
+	e.Attribute("action", value)
+	return e
+}
+
+// ActionIf conditionally sets the "action" attribute
+// Only sets the attribute if the condition is true
+func (e *form) ActionIf(condition bool, value string) *form {
+	if condition {
+		e.Attribute("action", value)
+	}
+	return e
+}
+
+// Method sets the "method" attribute
+// Returns the element itself to enable method chaining
+func (e *form) Method(value string) *form {
+	e.Attribute("method", value)
+	return e
+}
+
+// MethodIf conditionally sets the "method" attribute
+// Only sets the attribute if the condition is true
+func (e *form) MethodIf(condition bool, value string) *form {
+	if condition {
+		e.Attribute("method", value)
+	}
+	return e
+}
+
+// Enctype sets the "enctype" attribute
+// Returns the element itself to enable method chaining
+func (e *form) Enctype(value string) *form {
+	e.Attribute("enctype", value)
+	return e
+}
+
+// EnctypeIf conditionally sets the "enctype" attribute
+// Only sets the attribute if the condition is true
+func (e *form) EnctypeIf(condition bool, value string) *form {
+	if condition {
+		e.Attribute("enctype", value)
+	}
+	return e
+}
+
+// Novalidate sets the "novalidate" attribute
+// Returns the element itself to enable method chaining
+func (e *form) Novalidate(value string) *form {
+	e.Attribute("novalidate", value)
+	return e
+}
+
+// NovalidateIf conditionally sets the "novalidate" attribute
+// Only sets the attribute if the condition is true
+func (e *form) NovalidateIf(condition bool, value string) *form {
+	if condition {
+		e.Attribute("novalidate", value)
+	}
+	return e
+}
+
+// Autocomplete sets the "autocomplete" attribute
+// Returns the element itself to enable method chaining
+func (e *form) Autocomplete(value string) *form {
+	e.Attribute("autocomplete", value)
+	return e
+}
+
+// AutocompleteIf conditionally sets the "autocomplete" attribute
+// Only sets the attribute if the condition is true
+func (e *form) AutocompleteIf(condition bool, value string) *form {
+	if condition {
+		e.Attribute("autocomplete", value)
+	}
+	return e
+}
+
+// H1 represents the <h1> HTML element
+type h1 struct {
+	// Embeds the base Tag to inherit core HTML element functionality
+	*Tag
+}
+
+// H1 creates a new h1 element
+// Allows optional child nodes to be passed during creation
+func H1(children ...Node) *h1 {
+	return &h1{NewTag("h1", false, children)}
+}
+
+// H2 represents the <h2> HTML element
+type h2 struct {
+	// Embeds the base Tag to inherit core HTML element functionality
+	*Tag
+}
+
+// H2 creates a new h2 element
+// Allows optional child nodes to be passed during creation
+func H2(children ...Node) *h2 {
+	return &h2{NewTag("h2", false, children)}
+}
+
+// H3 represents the <h3> HTML element
+type h3 struct {
+	// Embeds the base Tag to inherit core HTML element functionality
+	*Tag
+}
+
+// H3 creates a new h3 element
+// Allows optional child nodes to be passed during creation
+func H3(children ...Node) *h3 {
+	return &h3{NewTag("h3", false, children)}
+}
+
+// H4 represents the <h4> HTML element
+type h4 struct {
+	// Embeds the base Tag to inherit core HTML element functionality
+	*Tag
+}
+
+// H4 creates a new h4 element
+// Allows optional child nodes to be passed during creation
+func H4(children ...Node) *h4 {
+	return &h4{NewTag("h4", false, children)}
+}
+
+// H5 represents the <h5> HTML element
+type h5 struct {
+	// Embeds the base Tag to inherit core HTML element functionality
+	*Tag
+}
+
+// H5 creates a new h5 element
+// Allows optional child nodes to be passed during creation
+func H5(children ...Node) *h5 {
+	return &h5{NewTag("h5", false, children)}
+}
+
+// H6 represents the <h6> HTML element
+type h6 struct {
+	// Embeds the base Tag to inherit core HTML element functionality
+	*Tag
+}
+
+// H6 creates a new h6 element
+// Allows optional child nodes to be passed during creation
+func H6(children ...Node) *h6 {
+	return &h6{NewTag("h6", false, children)}
+}
+
+// Head represents the <head> HTML element
+type head struct {
+	// Embeds the base Tag to inherit core HTML element functionality
+	*Tag
+}
+
+// Head creates a new head element
+// Allows optional child nodes to be passed during creation
+func Head(children ...Node) *head {
+	return &head{NewTag("head", false, children)}
+}
+
+// Header represents the <header> HTML element
+type header struct {
+	// Embeds the base Tag to inherit core HTML element functionality
+	*Tag
+}
+
+// Header creates a new header element
+// Allows optional child nodes to be passed during creation
+func Header(children ...Node) *header {
+	return &header{NewTag("header", false, children)}
+}
+
+// Hr represents the <hr> HTML element
+type hr struct {
+	// Embeds the base Tag to inherit core HTML element functionality
+	*Tag
+}
+
+// Hr creates a new hr element
+// Takes no children since a void element can't have any
+func Hr() *hr {
+	return &hr{NewTag("hr", voidElements["hr"], nil)}
+}
+
+// Html represents the <html> HTML element
+type html_ struct {
+	// Embeds the base Tag to inherit core HTML element functionality
+	*Tag
+}
+
+// Lang sets the "lang" attribute
+// Returns the element itself to enable method chaining
+func (e *html_) Lang(value string) *html_ {
+	e.Attribute("lang", value)
+	return e
+}
+
+// LangIf conditionally sets the "lang" attribute
+// Only sets the attribute if the condition is true
+func (e *html_) LangIf(condition bool, value string) *html_ {
+	if condition {
+		e.Attribute("lang", value)
+	}
+	return e
+}
+
+// Classes sets the "class" attribute, normalized like Tag.Class
+// Returns the element itself to enable method chaining
+func (e *html_) Classes(values ...string) *html_ {
+	e.Attribute("class", Classes(values...))
+	return e
+}
+
+// ClassIf conditionally sets the "class" attribute
+// Only sets the attribute if the condition is true
+func (e *html_) ClassIf(condition bool, value string) *html_ {
+	if condition {
+		e.Class(value)
+	}
+	return e
+}
+
+// HTML creates a new html element
+// Allows optional child nodes to be passed during creation
+func HTML(children ...Node) *html_ {
+	return &html_{NewTag("html", false, children)}
+}
+
+// I represents the <i> HTML element
+type i struct {
+	// Embeds the base Tag to inherit core HTML element functionality
+	*Tag
+}
+
+// I creates a new i element
+// Allows optional child nodes to be passed during creation
+func I(children ...Node) *i {
+	return &i{NewTag("i", false, children)}
+}
+
+// Iframe represents the <iframe> HTML element
+type iframe struct {
+	// Embeds the base Tag to inherit core HTML element functionality
+	*Tag
+}
+
+// Iframe creates a new iframe element
+// Allows optional child nodes to be passed during creation
+func Iframe(children ...Node) *iframe {
+	return &iframe{NewTag("iframe", false, children)}
+}
+
+// Allow sets the "allow" attribute
+// Returns the element itself to enable method chaining
+func (e *iframe) Allow(value string) *iframe {
+	e.Attribute("allow", value)
+	return e
+}
+
+// AllowIf conditionally sets the "allow" attribute
+// Only sets the attribute if the condition is true
+func (e *iframe) AllowIf(condition bool, value string) *iframe {
+	if condition {
+		e.Attribute("allow", value)
+	}
+	return e
+}
+
+// Allowfullscreen sets the "allowfullscreen" attribute
+// Returns the element itself to enable method chaining
+func (e *iframe) Allowfullscreen(value string) *iframe {
+	e.Attribute("allowfullscreen", value)
+	return e
+}
+
+// AllowfullscreenIf conditionally sets the "allowfullscreen" attribute
+// Only sets the attribute if the condition is true
+func (e *iframe) AllowfullscreenIf(condition bool, value string) *iframe {
+	if condition {
+		e.Attribute("allowfullscreen", value)
+	}
+	return e
+}
+
+// Sandbox sets the "sandbox" attribute
+// Returns the element itself to enable method chaining
+func (e *iframe) Sandbox(value string) *iframe {
+	e.Attribute("sandbox", value)
+	return e
+}
+
+// SandboxIf conditionally sets the "sandbox" attribute
+// Only sets the attribute if the condition is true
+func (e *iframe) SandboxIf(condition bool, value string) *iframe {
+	if condition {
+		e.Attribute("sandbox", value)
+	}
+	return e
+}
+
+// Srcdoc sets the "srcdoc" attribute
+// Returns the element itself to enable method chaining
+func (e *iframe) Srcdoc(value string) *iframe {
+	e.Attribute("srcdoc", value)
+	return e
+}
+
+// SrcdocIf conditionally sets the "srcdoc" attribute
+// Only sets the attribute if the condition is true
+func (e *iframe) SrcdocIf(condition bool, value string) *iframe {
+	if condition {
+		e.Attribute("srcdoc", value)
+	}
+	return e
+}
+
+// Loading sets the "loading" attribute
+// Returns the element itself to enable method chaining
+func (e *iframe) Loading(value string) *iframe {
+	e.Attribute("loading", value)
+	return e
+}
+
+// LoadingIf conditionally sets the "loading" attribute
+// Only sets the attribute if the condition is true
+func (e *iframe) LoadingIf(condition bool, value string) *iframe {
+	if condition {
+		e.Attribute("loading", value)
+	}
+	return e
+}
+
+// Img represents the <img> HTML element
+type img struct {
+	// Embeds the base Tag to inherit core HTML element functionality
+	*Tag
+}
+
+// Img creates a new img element
+// Takes no children since a void element can't have any
+func Img() *img {
+	return &img{NewTag("img", voidElements["img"], nil)}
+}
+
+// Src sets the "src" attribute
+// Returns the element itself to enable method chaining
+func (e *img) Src(value string) *img {
+	e.Attribute("src", value)
+	return e
+}
+
+// SrcIf conditionally sets the "src" attribute
+// Only sets the attribute if the condition is true
+func (e *img) SrcIf(condition bool, value string) *img {
+	if condition {
+		e.Attribute("src", value)
+	}
+	return e
+}
+
+// Alt sets the "alt" attribute
+// Returns the element itself to enable method chaining
+func (e *img) Alt(value string) *img {
+	e.Attribute("alt", value)
+	return e
+}
+
+// AltIf conditionally sets the "alt" attribute
+// Only sets the attribute if the condition is true
+func (e *img) AltIf(condition bool, value string) *img {
+	if condition {
+		e.Attribute("alt", value)
+	}
+	return e
+}
+
+// Width sets the "width" attribute
+// Returns the element itself to enable method chaining
+func (e *img) Width(value string) *img {
+	e.Attribute("width", value)
+	return e
+}
+
+// WidthIf conditionally sets the "width" attribute
+// Only sets the attribute if the condition is true
+func (e *img) WidthIf(condition bool, value string) *img {
+	if condition {
+		e.Attribute("width", value)
+	}
+	return e
+}
+
+// WidthInt sets the "width" attribute to an integer value
+// Returns the element itself to enable method chaining
+func (e *img) WidthInt(value int) *img {
+	e.AttributeInt("width", value)
+	return e
+}
+
+// Height sets the "height" attribute
+// Returns the element itself to enable method chaining
+func (e *img) Height(value string) *img {
+	e.Attribute("height", value)
+	return e
+}
+
+// HeightIf conditionally sets the "height" attribute
+// Only sets the attribute if the condition is true
+func (e *img) HeightIf(condition bool, value string) *img {
+	if condition {
+		e.Attribute("height", value)
+	}
+	return e
+}
+
+// HeightInt sets the "height" attribute to an integer value
+// Returns the element itself to enable method chaining
+func (e *img) HeightInt(value int) *img {
+	e.AttributeInt("height", value)
+	return e
+}
+
+// Loading sets the "loading" attribute
+// Returns the element itself to enable method chaining
+func (e *img) Loading(value string) *img {
+	e.Attribute("loading", value)
+	return e
+}
+
+// LoadingIf conditionally sets the "loading" attribute
+// Only sets the attribute if the condition is true
+func (e *img) LoadingIf(condition bool, value string) *img {
+	if condition {
+		e.Attribute("loading", value)
+	}
+	return e
+}
+
+// Fetchpriority sets the "fetchpriority" attribute
+// Returns the element itself to enable method chaining
+func (e *img) Fetchpriority(value string) *img {
+	e.Attribute("fetchpriority", value)
+	return e
+}
+
+// FetchpriorityIf conditionally sets the "fetchpriority" attribute
+// Only sets the attribute if the condition is true
+func (e *img) FetchpriorityIf(condition bool, value string) *img {
+	if condition {
+		e.Attribute("fetchpriority", value)
+	}
+	return e
+}
+
+// Referrerpolicy sets the "referrerpolicy" attribute
+// Returns the element itself to enable method chaining
+func (e *img) Referrerpolicy(value string) *img {
+	e.Attribute("referrerpolicy", value)
+	return e
+}
+
+// ReferrerpolicyIf conditionally sets the "referrerpolicy" attribute
+// Only sets the attribute if the condition is true
+func (e *img) ReferrerpolicyIf(condition bool, value string) *img {
+	if condition {
+		e.Attribute("referrerpolicy", value)
+	}
+	return e
+}
+
+// Srcset sets the "srcset" attribute
+// Returns the element itself to enable method chaining
+func (e *img) Srcset(value string) *img {
+	e.Attribute("srcset", value)
+	return e
+}
+
+// SrcsetIf conditionally sets the "srcset" attribute
+// Only sets the attribute if the condition is true
+func (e *img) SrcsetIf(condition bool, value string) *img {
+	if condition {
+		e.Attribute("srcset", value)
+	}
+	return e
+}
+
+// Sizes sets the "sizes" attribute
+// Returns the element itself to enable method chaining
+func (e *img) Sizes(value string) *img {
+	e.Attribute("sizes", value)
+	return e
+}
+
+// SizesIf conditionally sets the "sizes" attribute
+// Only sets the attribute if the condition is true
+func (e *img) SizesIf(condition bool, value string) *img {
+	if condition {
+		e.Attribute("sizes", value)
+	}
+	return e
+}
+
+// Crossorigin sets the "crossorigin" attribute
+// Returns the element itself to enable method chaining
+func (e *img) Crossorigin(value string) *img {
+	e.Attribute("crossorigin", value)
+	return e
+}
+
+// CrossoriginIf conditionally sets the "crossorigin" attribute
+// Only sets the attribute if the condition is true
+func (e *img) CrossoriginIf(condition bool, value string) *img {
+	if condition {
+		e.Attribute("crossorigin", value)
+	}
+	return e
+}
+
+// Decoding sets the "decoding" attribute
+// Returns the element itself to enable method chaining
+func (e *img) Decoding(value string) *img {
+	e.Attribute("decoding", value)
+	return e
+}
+
+// DecodingIf conditionally sets the "decoding" attribute
+// Only sets the attribute if the condition is true
+func (e *img) DecodingIf(condition bool, value string) *img {
+	if condition {
+		e.Attribute("decoding", value)
+	}
+	return e
+}
+
+// Ismap sets the "ismap" attribute
+// Returns the element itself to enable method chaining
+func (e *img) Ismap(value string) *img {
+	e.Attribute("ismap", value)
+	return e
+}
+
+// IsmapIf conditionally sets the "ismap" attribute
+// Only sets the attribute if the condition is true
+func (e *img) IsmapIf(condition bool, value string) *img {
+	if condition {
+		e.Attribute("ismap", value)
+	}
+	return e
+}
+
+// Usemap sets the "usemap" attribute
+// Returns the element itself to enable method chaining
+func (e *img) Usemap(value string) *img {
+	e.Attribute("usemap", value)
+	return e
+}
+
+// UsemapIf conditionally sets the "usemap" attribute
+// Only sets the attribute if the condition is true
+func (e *img) UsemapIf(condition bool, value string) *img {
+	if condition {
+		e.Attribute("usemap", value)
+	}
+	return e
+}
+
+// Input represents the <input> HTML element
+type input struct {
+	// Embeds the base Tag to inherit core HTML element functionality
+	*Tag
+}
+
+// Input creates a new input element
+// Takes no children since a void element can't have any
+func Input() *input {
+	return &input{NewTag("input", voidElements["input"], nil)}
+}
+
+// Name sets the "name" attribute
+// Returns the element itself to enable method chaining
+func (e *input) Name(values ...string) *input {
+	e.Attribute("name", strings.Join(values, " "))
+	return e
+}
+
+// NameIf conditionally sets the "name" attribute
+// Only sets the attribute if the condition is true
+func (e *input) NameIf(condition bool, value string) *input {
+	if condition {
+		e.Attribute("name", value)
+	}
+	return e
+}
+
+// Id sets the "id" attribute
+// Returns the element itself to enable method chaining
+func (e *input) Id(values ...string) *input {
+	e.Attribute("id", strings.Join(values, " "))
+	return e
+}
+
+// IdIf conditionally sets the "id" attribute
+// Only sets the attribute if the condition is true
+func (e *input) IdIf(condition bool, value string) *input {
+	if condition {
+		e.Attribute("id", value)
+	}
+	return e
+}
+
+// Type sets the "type" attribute
+// Returns the element itself to enable method chaining
+func (e *input) Type(value string) *input {
+	e.Attribute("type", value)
+	return e
+}
+
+// TypeIf conditionally sets the "type" attribute
+// Only sets the attribute if the condition is true
+func (e *input) TypeIf(condition bool, value string) *input {
+	if condition {
+		e.Attribute("type", value)
+	}
+	return e
+}
+
+// Placeholder sets the "placeholder" attribute
+// Returns the element itself to enable method chaining
+func (e *input) Placeholder(value string) *input {
+	e.Attribute("placeholder", value)
+	return e
+}
+
+// PlaceholderIf conditionally sets the "placeholder" attribute
+// Only sets the attribute if the condition is true
+func (e *input) PlaceholderIf(condition bool, value string) *input {
+	if condition {
+		e.Attribute("placeholder", value)
+	}
+	return e
+}
+
+// Required sets the "required" attribute
+// Returns the element itself to enable method chaining
+func (e *input) Required(value string) *input {
+	e.Attribute("required", value)
+	return e
+}
+
+// RequiredIf conditionally sets the "required" attribute
+// Only sets the attribute if the condition is true
+func (e *input) RequiredIf(condition bool, value string) *input {
+	if condition {
+		e.Attribute("required", value)
+	}
+	return e
+}
+
+// Readonly sets the "readonly" attribute
+// Returns the element itself to enable method chaining
+func (e *input) Readonly(value string) *input {
+	e.Attribute("readonly", value)
+	return e
+}
+
+// ReadonlyIf conditionally sets the "readonly" attribute
+// Only sets the attribute if the condition is true
+func (e *input) ReadonlyIf(condition bool, value string) *input {
+	if condition {
+		e.Attribute("readonly", value)
+	}
+	return e
+}
+
+// Checked sets the "checked" attribute
+// Returns the element itself to enable method chaining
+func (e *input) Checked(value string) *input {
+	e.Attribute("checked", value)
+	return e
+}
+
+// CheckedIf conditionally sets the "checked" attribute
+// Only sets the attribute if the condition is true
+func (e *input) CheckedIf(condition bool, value string) *input {
+	if condition {
+		e.Attribute("checked", value)
+	}
+	return e
+}
+
+// Min sets the "min" attribute
+// Returns the element itself to enable method chaining
+func (e *input) Min(value string) *input {
+	e.Attribute("min", value)
+	return e
+}
+
+// MinIf conditionally sets the "min" attribute
+// Only sets the attribute if the condition is true
+func (e *input) MinIf(condition bool, value string) *input {
+	if condition {
+		e.Attribute("min", value)
+	}
+	return e
+}
+
+// Max sets the "max" attribute
+// Returns the element itself to enable method chaining
+func (e *input) Max(value string) *input {
+	e.Attribute("max", value)
+	return e
+}
+
+// MaxIf conditionally sets the "max" attribute
+// Only sets the attribute if the condition is true
+func (e *input) MaxIf(condition bool, value string) *input {
+	if condition {
+		e.Attribute("max", value)
+	}
+	return e
+}
+
+// Pattern sets the "pattern" attribute
+// Returns the element itself to enable method chaining
+func (e *input) Pattern(value string) *input {
+	e.Attribute("pattern", value)
+	return e
+}
+
+// PatternIf conditionally sets the "pattern" attribute
+// Only sets the attribute if the condition is true
+func (e *input) PatternIf(condition bool, value string) *input {
+	if condition {
+		e.Attribute("pattern", value)
+	}
+	return e
+}
+
+// Value sets the "value" attribute, an empty value is kept, so value="" can clear a prefilled input
+// Returns the element itself to enable method chaining
+func (e *input) Value(value string) *input {
+	e.AttributeIf(true, "value", value)
+	return e
+}
+
+// ValueIf conditionally sets the "value" attribute
+// Only sets the attribute if the condition is true
+func (e *input) ValueIf(condition bool, value string) *input {
+	e.AttributeIf(condition, "value", value)
+	return e
+}
+
+// Step sets the "step" attribute
+// Returns the element itself to enable method chaining
+func (e *input) Step(value string) *input {
+	e.Attribute("step", value)
+	return e
+}
+
+// StepIf conditionally sets the "step" attribute
+// Only sets the attribute if the condition is true
+func (e *input) StepIf(condition bool, value string) *input {
+	if condition {
+		e.Attribute("step", value)
+	}
+	return e
+}
+
+// List sets the "list" attribute
+// Returns the element itself to enable method chaining
+func (e *input) List(value string) *input {
+	e.Attribute("list", value)
+	return e
+}
+
+// ListIf conditionally sets the "list" attribute
+// Only sets the attribute if the condition is true
+func (e *input) ListIf(condition bool, value string) *input {
+	if condition {
+		e.Attribute("list", value)
+	}
+	return e
+}
+
+// Accept sets the "accept" attribute
+// Returns the element itself to enable method chaining
+func (e *input) Accept(value string) *input {
+	e.Attribute("accept", value)
+	return e
+}
+
+// AcceptIf conditionally sets the "accept" attribute
+// Only sets the attribute if the condition is true
+func (e *input) AcceptIf(condition bool, value string) *input {
+	if condition {
+		e.Attribute("accept", value)
+	}
+	return e
+}
+
+// Multiple sets the "multiple" attribute
+// Returns the element itself to enable method chaining
+func (e *input) Multiple(value string) *input {
+	e.Attribute("multiple", value)
+	return e
+}
+
+// MultipleIf conditionally sets the "multiple" attribute
+// Only sets the attribute if the condition is true
+func (e *input) MultipleIf(condition bool, value string) *input {
+	if condition {
+		e.Attribute("multiple", value)
+	}
+	return e
+}
+
+// Autocomplete sets the "autocomplete" attribute
+// Returns the element itself to enable method chaining
+func (e *input) Autocomplete(value string) *input {
+	e.Attribute("autocomplete", value)
+	return e
+}
+
+// AutocompleteIf conditionally sets the "autocomplete" attribute
+// Only sets the attribute if the condition is true
+func (e *input) AutocompleteIf(condition bool, value string) *input {
+	if condition {
+		e.Attribute("autocomplete", value)
+	}
+	return e
+}
+
+// Autofocus sets the "autofocus" attribute
+// Returns the element itself to enable method chaining
+func (e *input) Autofocus(value string) *input {
+	e.Attribute("autofocus", value)
+	return e
+}
+
+// AutofocusIf conditionally sets the "autofocus" attribute
+// Only sets the attribute if the condition is true
+func (e *input) AutofocusIf(condition bool, value string) *input {
+	if condition {
+		e.Attribute("autofocus", value)
+	}
+	return e
+}
+
+// Disabled sets the "disabled" attribute
+// Returns the element itself to enable method chaining
+func (e *input) Disabled(value string) *input {
+	e.Attribute("disabled", value)
+	return e
+}
+
+// DisabledIf conditionally sets the "disabled" attribute
+// Only sets the attribute if the condition is true
+func (e *input) DisabledIf(condition bool, value string) *input {
+	if condition {
+		e.Attribute("disabled", value)
+	}
+	return e
+}
+
+// Ins represents the <ins> HTML element
+type ins struct {
+	// Embeds the base Tag to inherit core HTML element functionality
+	*Tag
+}
+
+// Ins creates a new ins element
+// Allows optional child nodes to be passed during creation
+func Ins(children ...Node) *ins {
+	return &ins{NewTag("ins", false, children)}
+}
+
+// Kbd represents the <kbd> HTML element
+type kbd struct {
+	// Embeds the base Tag to inherit core HTML element functionality
+	*Tag
+}
+
+// Kbd creates a new kbd element
+// Allows optional child nodes to be passed during creation
+func Kbd(children ...Node) *kbd {
+	return &kbd{NewTag("kbd", false, children)}
+}
+
+// Label represents the <label> HTML element
+type label struct {
+	// Embeds the base Tag to inherit core HTML element functionality
+	*Tag
+}
+
+// Label creates a new label element
+// Allows optional child nodes to be passed during creation
+func Label(children ...Node) *label {
+	return &label{NewTag("label", false, children)}
+}
+
+// For sets the "for" attribute
+// Returns the element itself to enable method chaining
+func (e *label) For(value string) *label {
+	e.Attribute("for", value)
+	return e
+}
+
+// ForIf conditionally sets the "for" attribute
+// Only sets the attribute if the condition is true
+func (e *label) ForIf(condition bool, value string) *label {
+	if condition {
+		e.Attribute("for", value)
+	}
+	return e
+}
+
+// Legend represents the <legend> HTML element
+type legend struct {
+	// Embeds the base Tag to inherit core HTML element functionality
+	*Tag
+}
+
+// Legend creates a new legend element
+// Allows optional child nodes to be passed during creation
+func Legend(children ...Node) *legend {
+	return &legend{NewTag("legend", false, children)}
+}
+
+// Li represents the <li> HTML element
+type li struct {
+	// Embeds the base Tag to inherit core HTML element functionality
+	*Tag
+}
+
+// Li creates a new li element
+// Allows optional child nodes to be passed during creation
+func Li(children ...Node) *li {
+	return &li{NewTag("li", false, children)}
+}
+
+// Link represents the <link> HTML element
+type link struct {
+	// Embeds the base Tag to inherit core HTML element functionality
+	*Tag
+}
+
+// Rel sets the "rel" attribute
+// Returns the element itself to enable method chaining
+func (e *link) Rel(value string) *link {
+	e.Attribute("rel", value)
+	return e
+}
+
+// RelIf conditionally sets the "rel" attribute
+// Only sets the attribute if the condition is true
+func (e *link) RelIf(condition bool, value string) *link {
+	if condition {
+		e.Attribute("rel", value)
+	}
+	return e
+}
+
+// Href sets the "href" attribute
+// Returns the element itself to enable method chaining
+func (e *link) Href(value string) *link {
+	e.Attribute("href", value)
+	return e
+}
+
+// HrefIf conditionally sets the "href" attribute
+// Only sets the attribute if the condition is true
+func (e *link) HrefIf(condition bool, value string) *link {
+	if condition {
+		e.Attribute("href", value)
+	}
+	return e
+}
+
+// Link creates a new link element
+// Takes no children since a void element can't have any
+func Link() *link {
+	return &link{NewTag("link", voidElements["link"], nil)}
+}
+
+// Integrity sets the "integrity" attribute
+// Returns the element itself to enable method chaining
+func (e *link) Integrity(value string) *link {
+	e.Attribute("integrity", value)
+	return e
+}
+
+// IntegrityIf conditionally sets the "integrity" attribute
+// Only sets the attribute if the condition is true
+func (e *link) IntegrityIf(condition bool, value string) *link {
+	if condition {
+		e.Attribute("integrity", value)
+	}
+	return e
+}
+
+// As sets the "as" attribute
+// Returns the element itself to enable method chaining
+func (e *link) As(value string) *link {
+	e.Attribute("as", value)
+	return e
+}
+
+// AsIf conditionally sets the "as" attribute
+// Only sets the attribute if the condition is true
+func (e *link) AsIf(condition bool, value string) *link {
+	if condition {
+		e.Attribute("as", value)
+	}
+	return e
+}
+
+// Crossorigin sets the "crossorigin" attribute
+// Returns the element itself to enable method chaining
+func (e *link) Crossorigin(value string) *link {
+	e.Attribute("crossorigin", value)
+	return e
+}
+
+// CrossoriginIf conditionally sets the "crossorigin" attribute
+// Only sets the attribute if the condition is true
+func (e *link) CrossoriginIf(condition bool, value string) *link {
+	if condition {
+		e.Attribute("crossorigin", value)
+	}
+	return e
+}
+
+// Media sets the "media" attribute
+// Returns the element itself to enable method chaining
+func (e *link) Media(value string) *link {
+	e.Attribute("media", value)
+	return e
+}
+
+// MediaIf conditionally sets the "media" attribute
+// Only sets the attribute if the condition is true
+func (e *link) MediaIf(condition bool, value string) *link {
+	if condition {
+		e.Attribute("media", value)
+	}
+	return e
+}
+
+// Type sets the "type" attribute
+// Returns the element itself to enable method chaining
+func (e *link) Type(value string) *link {
+	e.Attribute("type", value)
+	return e
+}
+
+// TypeIf conditionally sets the "type" attribute
+// Only sets the attribute if the condition is true
+func (e *link) TypeIf(condition bool, value string) *link {
+	if condition {
+		e.Attribute("type", value)
+	}
+	return e
+}
+
+// Sizes sets the "sizes" attribute
+// Returns the element itself to enable method chaining
+func (e *link) Sizes(value string) *link {
+	e.Attribute("sizes", value)
+	return e
+}
+
+// SizesIf conditionally sets the "sizes" attribute
+// Only sets the attribute if the condition is true
+func (e *link) SizesIf(condition bool, value string) *link {
+	if condition {
+		e.Attribute("sizes", value)
+	}
+	return e
+}
+
+// Imagesrcset sets the "imagesrcset" attribute
+// Returns the element itself to enable method chaining
+func (e *link) Imagesrcset(value string) *link {
+	e.Attribute("imagesrcset", value)
+	return e
+}
+
+// ImagesrcsetIf conditionally sets the "imagesrcset" attribute
+// Only sets the attribute if the condition is true
+func (e *link) ImagesrcsetIf(condition bool, value string) *link {
+	if condition {
+		e.Attribute("imagesrcset", value)
+	}
+	return e
+}
+
+// Imagesizes sets the "imagesizes" attribute
+// Returns the element itself to enable method chaining
+func (e *link) Imagesizes(value string) *link {
+	e.Attribute("imagesizes", value)
+	return e
+}
+
+// ImagesizesIf conditionally sets the "imagesizes" attribute
+// Only sets the attribute if the condition is true
+func (e *link) ImagesizesIf(condition bool, value string) *link {
+	if condition {
+		e.Attribute("imagesizes", value)
+	}
+	return e
+}
+
+// Fetchpriority sets the "fetchpriority" attribute
+// Returns the element itself to enable method chaining
+func (e *link) Fetchpriority(value string) *link {
+	e.Attribute("fetchpriority", value)
+	return e
+}
+
+// FetchpriorityIf conditionally sets the "fetchpriority" attribute
+// Only sets the attribute if the condition is true
+func (e *link) FetchpriorityIf(condition bool, value string) *link {
+	if condition {
+		e.Attribute("fetchpriority", value)
+	}
+	return e
+}
+
+// Main represents the <main> HTML element
+type main struct {
+	// Embeds the base Tag to inherit core HTML element functionality
+	*Tag
+}
+
+// Main creates a new main element
+// Allows optional child nodes to be passed during creation
+func Main(children ...Node) *main {
+	return &main{NewTag("main", false, children)}
+}
+
+// mapEl_ represents the <map> HTML element
+type mapEl_ struct {
+	// Embeds the base Tag to inherit core HTML element functionality
+	*Tag
+}
+
+// Map creates a new map element
+// Allows optional child nodes to be passed during creation
+func MapEl(children ...Node) *mapEl_ {
+	return &mapEl_{NewTag("map", false, children)}
+}
+
+// Mark represents the <mark> HTML element
+type mark struct {
+	// Embeds the base Tag to inherit core HTML element functionality
+	*Tag
+}
+
+// Mark creates a new mark element
+// Allows optional child nodes to be passed during creation
+func Mark(children ...Node) *mark {
+	return &mark{NewTag("mark", false, children)}
+}
+
+// Meta represents the <meta> HTML element
+type meta struct {
+	// Embeds the base Tag to inherit core HTML element functionality
+	*Tag
+}
+
+// Meta creates a new meta element
+// Takes no children since a void element can't have any
+func Meta() *meta {
+	return &meta{NewTag("meta", voidElements["meta"], nil)}
+}
+
+// Name sets the "name" attribute
+// Returns the element itself to enable method chaining
+func (e *meta) Name(value string) *meta {
+	e.Attribute("name", value)
+	return e
+}
+
+// NameIf conditionally sets the "name" attribute
+// Only sets the attribute if the condition is true
+func (e *meta) NameIf(condition bool, value string) *meta {
+	if condition {
+		e.Attribute("name", value)
+	}
+	return e
+}
+
+// Content sets the "content" attribute
+// Returns the element itself to enable method chaining
+func (e *meta) Content(value string) *meta {
+	e.Attribute("content", value)
+	return e
+}
+
+// ContentIf conditionally sets the "content" attribute
+// Only sets the attribute if the condition is true
+func (e *meta) ContentIf(condition bool, value string) *meta {
+	if condition {
+		e.Attribute("content", value)
+	}
+	return e
+}
+
+// Charset sets the "charset" attribute
+// Returns the element itself to enable method chaining
+func (e *meta) Charset(value string) *meta {
+	e.Attribute("charset", value)
+	return e
+}
+
+// CharsetIf conditionally sets the "charset" attribute
+// Only sets the attribute if the condition is true
+func (e *meta) CharsetIf(condition bool, value string) *meta {
+	if condition {
+		e.Attribute("charset", value)
+	}
+	return e
+}
+
+// HttpEquiv sets the "http-equiv" attribute
+// Returns the element itself to enable method chaining
+func (e *meta) HttpEquiv(value string) *meta {
+	e.Attribute("http-equiv", value)
+	return e
+}
+
+// HttpEquivIf conditionally sets the "http-equiv" attribute
+// Only sets the attribute if the condition is true
+func (e *meta) HttpEquivIf(condition bool, value string) *meta {
+	if condition {
+		e.Attribute("http-equiv", value)
+	}
+	return e
+}
+
+// Property sets the "property" attribute
+// Returns the element itself to enable method chaining
+func (e *meta) Property(value string) *meta {
+	e.Attribute("property", value)
+	return e
+}
+
+// PropertyIf conditionally sets the "property" attribute
+// Only sets the attribute if the condition is true
+func (e *meta) PropertyIf(condition bool, value string) *meta {
+	if condition {
+		e.Attribute("property", value)
+	}
+	return e
+}
+
+// Itemprop sets the "itemprop" attribute
+// Returns the element itself to enable method chaining
+func (e *meta) Itemprop(value string) *meta {
+	e.Attribute("itemprop", value)
+	return e
+}
+
+// ItempropIf conditionally sets the "itemprop" attribute
+// Only sets the attribute if the condition is true
+func (e *meta) ItempropIf(condition bool, value string) *meta {
+	if condition {
+		e.Attribute("itemprop", value)
+	}
+	return e
+}
+
+// Meter represents the <meter> HTML element
+type meter struct {
+	// Embeds the base Tag to inherit core HTML element functionality
+	*Tag
+}
+
+// Meter creates a new meter element
+// Allows optional child nodes to be passed during creation
+func Meter(children ...Node) *meter {
+	return &meter{NewTag("meter", false, children)}
+}
+
+// Value sets the "value" attribute
+// Returns the element itself to enable method chaining
+func (e *meter) Value(value string) *meter {
+	e.Attribute("value", value)
+	return e
+}
+
+// ValueIf conditionally sets the "value" attribute
+// Only sets the attribute if the condition is true
+func (e *meter) ValueIf(condition bool, value string) *meter {
+	if condition {
+		e.Attribute("value", value)
+	}
+	return e
+}
+
+// Min sets the "min" attribute
+// Returns the element itself to enable method chaining
+func (e *meter) Min(value string) *meter {
+	e.Attribute("min", value)
+	return e
+}
+
+// MinIf conditionally sets the "min" attribute
+// Only sets the attribute if the condition is true
+func (e *meter) MinIf(condition bool, value string) *meter {
+	if condition {
+		e.Attribute("min", value)
+	}
+	return e
+}
+
+// Max sets the "max" attribute
+// Returns the element itself to enable method chaining
+func (e *meter) Max(value string) *meter {
+	e.Attribute("max", value)
+	return e
+}
+
+// MaxIf conditionally sets the "max" attribute
+// Only sets the attribute if the condition is true
+func (e *meter) MaxIf(condition bool, value string) *meter {
+	if condition {
+		e.Attribute("max", value)
+	}
+	return e
+}
+
+// Low sets the "low" attribute
+// Returns the element itself to enable method chaining
+func (e *meter) Low(value string) *meter {
+	e.Attribute("low", value)
+	return e
+}
+
+// LowIf conditionally sets the "low" attribute
+// Only sets the attribute if the condition is true
+func (e *meter) LowIf(condition bool, value string) *meter {
+	if condition {
+		e.Attribute("low", value)
+	}
+	return e
+}
+
+// High sets the "high" attribute
+// Returns the element itself to enable method chaining
+func (e *meter) High(value string) *meter {
+	e.Attribute("high", value)
+	return e
+}
+
+// HighIf conditionally sets the "high" attribute
+// Only sets the attribute if the condition is true
+func (e *meter) HighIf(condition bool, value string) *meter {
+	if condition {
+		e.Attribute("high", value)
+	}
+	return e
+}
+
+// Optimum sets the "optimum" attribute
+// Returns the element itself to enable method chaining
+func (e *meter) Optimum(value string) *meter {
+	e.Attribute("optimum", value)
+	return e
+}
+
+// OptimumIf conditionally sets the "optimum" attribute
+// Only sets the attribute if the condition is true
+func (e *meter) OptimumIf(condition bool, value string) *meter {
+	if condition {
+		e.Attribute("optimum", value)
+	}
+	return e
+}
+
+// Nav represents the <nav> HTML element
+type nav struct {
+	// Embeds the base Tag to inherit core HTML element functionality
+	*Tag
+}
+
+// Nav creates a new nav element
+// Allows optional child nodes to be passed during creation
+func Nav(children ...Node) *nav {
+	return &nav{NewTag("nav", false, children)}
+}
+
+// Noscript represents the <noscript> HTML element
+type noscript struct {
+	// Embeds the base Tag to inherit core HTML element functionality
+	*Tag
+}
+
+// Noscript creates a new noscript element
+// Allows optional child nodes to be passed during creation
+func Noscript(children ...Node) *noscript {
+	return &noscript{NewTag("noscript", false, children)}
+}
+
+// Object represents the <object> HTML element
+type object struct {
+	// Embeds the base Tag to inherit core HTML element functionality
+	*Tag
+}
+
+// Object creates a new object element
+// Allows optional child nodes to be passed during creation
+func Object(children ...Node) *object {
+	return &object{NewTag("object", false, children)}
+}
+
+// Ol represents the <ol> HTML element
+type ol struct {
+	// Embeds the base Tag to inherit core HTML element functionality
+	*Tag
+}
+
+// Ol creates a new ol element
+// Allows optional child nodes to be passed during creation
+func Ol(children ...Node) *ol {
+	return &ol{NewTag("ol", false, children)}
+}
+
+// Start sets the "start" attribute
+// Returns the element itself to enable method chaining
+func (e *ol) Start(value string) *ol {
+	e.Attribute("start", value)
+	return e
+}
+
+// StartIf conditionally sets the "start" attribute
+// Only sets the attribute if the condition is true
+func (e *ol) StartIf(condition bool, value string) *ol {
+	if condition {
+		e.Attribute("start", value)
+	}
+	return e
+}
+
+// Reversed sets the "reversed" attribute
+// Returns the element itself to enable method chaining
+func (e *ol) Reversed(value string) *ol {
+	e.Attribute("reversed", value)
+	return e
+}
+
+// ReversedIf conditionally sets the "reversed" attribute
+// Only sets the attribute if the condition is true
+func (e *ol) ReversedIf(condition bool, value string) *ol {
+	if condition {
+		e.Attribute("reversed", value)
+	}
+	return e
+}
+
+// Optgroup represents the <optgroup> HTML element
+type optgroup struct {
+	// Embeds the base Tag to inherit core HTML element functionality
+	*Tag
+}
+
+// Optgroup creates a new optgroup element
+// Allows optional child nodes to be passed during creation
+func Optgroup(children ...Node) *optgroup {
+	return &optgroup{NewTag("optgroup", false, children)}
+}
+
+// Label sets the "label" attribute
+// Returns the element itself to enable method chaining
+func (e *optgroup) Label(value string) *optgroup {
+	e.Attribute("label", value)
+	return e
+}
+
+// LabelIf conditionally sets the "label" attribute
+// Only sets the attribute if the condition is true
+func (e *optgroup) LabelIf(condition bool, value string) *optgroup {
+	if condition {
+		e.Attribute("label", value)
+	}
+	return e
+}
+
+// Disabled sets the "disabled" attribute
+// Returns the element itself to enable method chaining
+func (e *optgroup) Disabled(value string) *optgroup {
+	e.Attribute("disabled", value)
+	return e
+}
+
+// DisabledIf conditionally sets the "disabled" attribute
+// Only sets the attribute if the condition is true
+func (e *optgroup) DisabledIf(condition bool, value string) *optgroup {
+	if condition {
+		e.Attribute("disabled", value)
+	}
+	return e
+}
+
+// Option represents the <option> HTML element
+type option struct {
+	// Embeds the base Tag to inherit core HTML element functionality
+	*Tag
+}
+
+// Option creates a new option element
+// Allows optional child nodes to be passed during creation
+func Option(children ...Node) *option {
+	return &option{NewTag("option", false, children)}
+}
+
+// Selected sets the "selected" attribute
+// Returns the element itself to enable method chaining
+func (e *option) Selected(value string) *option {
+	e.Attribute("selected", value)
+	return e
+}
+
+// SelectedIf conditionally sets the "selected" attribute
+// Only sets the attribute if the condition is true
+func (e *option) SelectedIf(condition bool, value string) *option {
+	if condition {
+		e.Attribute("selected", value)
+	}
+	return e
+}
+
+// Value sets the "value" attribute, an empty value is kept, so a placeholder
+// option submits "" rather than its label and a required select rejects it
+// Returns the element itself to enable method chaining
+func (e *option) Value(value string) *option {
+	e.AttributeIf(true, "value", value)
+	return e
+}
+
+// ValueIf conditionally sets the "value" attribute
+// Only sets the attribute if the condition is true
+func (e *option) ValueIf(condition bool, value string) *option {
+	e.AttributeIf(condition, "value", value)
+	return e
+}
+
+// Disabled sets the "disabled" attribute
+// Returns the element itself to enable method chaining
+func (e *option) Disabled(value string) *option {
+	e.Attribute("disabled", value)
+	return e
+}
+
+// DisabledIf conditionally sets the "disabled" attribute
+// Only sets the attribute if the condition is true
+func (e *option) DisabledIf(condition bool, value string) *option {
+	if condition {
+		e.Attribute("disabled", value)
+	}
+	return e
+}
+
+// Label sets the "label" attribute
+// Returns the element itself to enable method chaining
+func (e *option) Label(value string) *option {
+	e.Attribute("label", value)
+	return e
+}
+
+// LabelIf conditionally sets the "label" attribute
+// Only sets the attribute if the condition is true
+func (e *option) LabelIf(condition bool, value string) *option {
+	if condition {
+		e.Attribute("label", value)
+	}
+	return e
+}
+
+// Output represents the <output> HTML element
+type output struct {
+	// Embeds the base Tag to inherit core HTML element functionality
+	*Tag
+}
+
+// Output creates a new output element
+// Allows optional child nodes to be passed during creation
+func Output(children ...Node) *output {
+	return &output{NewTag("output", false, children)}
+}
+
+// P represents the <p> HTML element
+type p struct {
+	// Embeds the base Tag to inherit core HTML element functionality
+	*Tag
+}
+
+// P creates a new p element
+// Allows optional child nodes to be passed during creation
+func P(children ...Node) *p {
+	return &p{NewTag("p", false, children)}
+}
+
+// Param represents the <param> HTML element
+type param struct {
+	// Embeds the base Tag to inherit core HTML element functionality
+	*Tag
+}
+
+// Param creates a new param element
+// Takes no children since a void element can't have any
+func Param() *param {
+	return &param{NewTag("param", voidElements["param"], nil)}
+}
+
+// Picture represents the <picture> HTML element
+type picture struct {
+	// Embeds the base Tag to inherit core HTML element functionality
+	*Tag
+}
+
+// Picture creates a new picture element
+// Allows optional child nodes to be passed during creation
+func Picture(children ...Node) *picture {
+	return &picture{NewTag("picture", false, children)}
+}
+
+// Pre represents the <pre> HTML element
+type pre struct {
+	// Embeds the base Tag to inherit core HTML element functionality
+	*Tag
+}
+
+// Pre creates a new pre element
+// Allows optional child nodes to be passed during creation
+func Pre(children ...Node) *pre {
+	return &pre{NewTag("pre", false, children)}
+}
+
+// Progress represents the <progress> HTML element
+type progress struct {
+	// Embeds the base Tag to inherit core HTML element functionality
+	*Tag
+}
+
+// Progress creates a new progress element
+// Allows optional child nodes to be passed during creation
+func Progress(children ...Node) *progress {
+	return &progress{NewTag("progress", false, children)}
+}
+
+// Value sets the "value" attribute
+// Returns the element itself to enable method chaining
+func (e *progress) Value(value string) *progress {
+	e.Attribute("value", value)
+	return e
+}
+
+// ValueIf conditionally sets the "value" attribute
+// Only sets the attribute if the condition is true
+func (e *progress) ValueIf(condition bool, value string) *progress {
+	if condition {
+		e.Attribute("value", value)
+	}
+	return e
+}
+
+// Max sets the "max" attribute
+// Returns the element itself to enable method chaining
+func (e *progress) Max(value string) *progress {
+	e.Attribute("max", value)
+	return e
+}
+
+// MaxIf conditionally sets the "max" attribute
+// Only sets the attribute if the condition is true
+func (e *progress) MaxIf(condition bool, value string) *progress {
+	if condition {
+		e.Attribute("max", value)
+	}
+	return e
+}
+
+// Q represents the <q> HTML element
+type q struct {
+	// Embeds the base Tag to inherit core HTML element functionality
+	*Tag
+}
+
+// Q creates a new q element
+// Allows optional child nodes to be passed during creation
+func Q(children ...Node) *q {
+	return &q{NewTag("q", false, children)}
+}
+
+// Rp represents the <rp> HTML element
+type rp struct {
+	// Embeds the base Tag to inherit core HTML element functionality
+	*Tag
+}
+
+// Rp creates a new rp element
+// Allows optional child nodes to be passed during creation
+func Rp(children ...Node) *rp {
+	return &rp{NewTag("rp", false, children)}
+}
+
+// Rt represents the <rt> HTML element
+type rt struct {
+	// Embeds the base Tag to inherit core HTML element functionality
+	*Tag
+}
+
+// Rt creates a new rt element
+// Allows optional child nodes to be passed during creation
+func Rt(children ...Node) *rt {
+	return &rt{NewTag("rt", false, children)}
+}
+
+// Ruby represents the <ruby> HTML element
+type ruby struct {
+	// Embeds the base Tag to inherit core HTML element functionality
+	*Tag
+}
+
+// Ruby creates a new ruby element
+// Allows optional child nodes to be passed during creation
+func Ruby(children ...Node) *ruby {
+	return &ruby{NewTag("ruby", false, children)}
+}
+
+// S represents the <s> HTML element
+type s struct {
+	// Embeds the base Tag to inherit core HTML element functionality
+	*Tag
+}
+
+// S creates a new s element
+// Allows optional child nodes to be passed during creation
+func S(children ...Node) *s {
+	return &s{NewTag("s", false, children)}
+}
+
+// Samp represents the <samp> HTML element
+type samp struct {
+	// Embeds the base Tag to inherit core HTML element functionality
+	*Tag
+}
+
+// Samp creates a new samp element
+// Allows optional child nodes to be passed during creation
+func Samp(children ...Node) *samp {
+	return &samp{NewTag("samp", false, children)}
+}
+
+// Script represents the <script> HTML element
+type script struct {
+	// Embeds the base Tag to inherit core HTML element functionality
+	*Tag
+}
+
+// Script creates a new script element
+// Allows optional child nodes to be passed during creation
+func Script(children ...Node) *script {
+	return &script{NewTag("script", false, children)}
+}
+
+// Async sets the "async" attribute
+// Returns the element itself to enable method chaining
+func (e *script) Async(value string) *script {
+	e.Attribute("async", value)
+	return e
+}
+
+// AsyncIf conditionally sets the "async" attribute
+// Only sets the attribute if the condition is true
+func (e *script) AsyncIf(condition bool, value string) *script {
+	if condition {
+		e.Attribute("async", value)
+	}
+	return e
+}
+
+// Defer sets the "defer" attribute
+// Returns the element itself to enable method chaining
+func (e *script) Defer(value string) *script {
+	e.Attribute("defer", value)
+	return e
+}
+
+// DeferIf conditionally sets the "defer" attribute
+// Only sets the attribute if the condition is true
+func (e *script) DeferIf(condition bool, value string) *script {
+	if condition {
+		e.Attribute("defer", value)
+	}
+	return e
+}
+
+// Nomodule sets the "nomodule" attribute
+// Returns the element itself to enable method chaining
+func (e *script) Nomodule(value string) *script {
+	e.Attribute("nomodule", value)
+	return e
+}
+
+// NomoduleIf conditionally sets the "nomodule" attribute
+// Only sets the attribute if the condition is true
+func (e *script) NomoduleIf(condition bool, value string) *script {
+	if condition {
+		e.Attribute("nomodule", value)
+	}
+	return e
+}
+
+// Type sets the "type" attribute
+// Returns the element itself to enable method chaining
+func (e *script) Type(value string) *script {
+	e.Attribute("type", value)
+	return e
+}
+
+// TypeIf conditionally sets the "type" attribute
+// Only sets the attribute if the condition is true
+func (e *script) TypeIf(condition bool, value string) *script {
+	if condition {
+		e.Attribute("type", value)
+	}
+	return e
+}
+
+// Src sets the "src" attribute
+// Returns the element itself to enable method chaining
+func (e *script) Src(value string) *script {
+	e.Attribute("src", value)
+	return e
+}
+
+// SrcIf conditionally sets the "src" attribute
+// Only sets the attribute if the condition is true
+func (e *script) SrcIf(condition bool, value string) *script {
+	if condition {
+		e.Attribute("src", value)
+	}
+	return e
+}
+
+// Nonce sets the "nonce" attribute used by Content-Security-Policy
+// Returns the element itself to enable method chaining
+func (e *script) Nonce(value string) *script {
+	e.Attribute("nonce", value)
+	return e
+}
+
+// NonceIf conditionally sets the "nonce" attribute
+// Only sets the attribute if the condition is true
+func (e *script) NonceIf(condition bool, value string) *script {
+	if condition {
+		e.Attribute("nonce", value)
+	}
+	return e
+}
+
+// Integrity sets the "integrity" attribute
+// Returns the element itself to enable method chaining
+func (e *script) Integrity(value string) *script {
+	e.Attribute("integrity", value)
+	return e
+}
+
+// IntegrityIf conditionally sets the "integrity" attribute
+// Only sets the attribute if the condition is true
+func (e *script) IntegrityIf(condition bool, value string) *script {
+	if condition {
+		e.Attribute("integrity", value)
+	}
+	return e
+}
+
+// Crossorigin sets the "crossorigin" attribute
+// Returns the element itself to enable method chaining
+func (e *script) Crossorigin(value string) *script {
+	e.Attribute("crossorigin", value)
+	return e
+}
+
+// CrossoriginIf conditionally sets the "crossorigin" attribute
+// Only sets the attribute if the condition is true
+func (e *script) CrossoriginIf(condition bool, value string) *script {
+	if condition {
+		e.Attribute("crossorigin", value)
+	}
+	return e
+}
+
+// Section represents the <section> HTML element
+type section struct {
+	// Embeds the base Tag to inherit core HTML element functionality
+	*Tag
+}
+
+// Section creates a new section element
+// Allows optional child nodes to be passed during creation
+func Section(children ...Node) *section {
+	return &section{NewTag("section", false, children)}
+}
+
+// Select represents the <select> HTML element
+type select_ struct {
 	// Embeds the base Tag to inherit core HTML element functionality
 	*Tag
 }
 
-// Details creates a new details element
+// Select creates a new select element
 // Allows optional child nodes to be passed during creation
-func Details(children ...Node) *details {
-	return &details{NewTag("details", false, children)}
+func Select(children ...Node) *select_ {
+	return &select_{NewTag("select", false, children)}
 }
 
-// Open sets the "open" attribute
+// Multiple sets the "multiple" attribute
 // Returns the element itself to enable method chaining
-func (e *details) Open(value string) *details {
-	e.Attribute("open", value)
+func (e *select_) Multiple(value string) *select_ {
+	e.Attribute("multiple", value)
 	return e
 }
 
-// OpenIf conditionally sets the "open" attribute
+// MultipleIf conditionally sets the "multiple" attribute
 // Only sets the attribute if the condition is true
-func (e *details) OpenIf(condition bool, value string) *details {
+func (e *select_) MultipleIf(condition bool, value string) *select_ {
 	if condition {
-		e.Attribute("open", value)
+		e.Attribute("multiple", value)
 	}
 	return e
 }
 
-// Dfn represents the <dfn> HTML element
-type dfn struct {
-	// Embeds the base Tag to inherit core HTML element functionality
-	*Tag
+// Size sets the "size" attribute
+// Returns the element itself to enable method chaining
+func (e *select_) Size(value string) *select_ {
+	e.Attribute("size", value)
+	return e
 }
 
-// Dfn creates a new dfn element
-// Allows optional child nodes to be passed during creation
-func Dfn(children ...Node) *dfn {
-	return &dfn{NewTag("dfn", false, children)}
+// SizeIf conditionally sets the "size" attribute
+// Only sets the attribute if the condition is true
+func (e *select_) SizeIf(condition bool, value string) *select_ {
+	if condition {
+		e.Attribute("size", value)
+	}
+	return e
 }
 
-// Dialog represents the <dialog> HTML element
-type dialog struct {
+// Small represents the <small> HTML element
+type small struct {
 	// Embeds the base Tag to inherit core HTML element functionality
 	*Tag
 }
 
-// Dialog creates a new dialog element
+// Small creates a new small element
 // Allows optional child nodes to be passed during creation
-func Dialog(children ...Node) *dialog {
-	return &dialog{NewTag("dialog", false, children)}
+func Small(children ...Node) *small {
+	return &small{NewTag("small", false, children)}
 }
 
-// Div represents the <div> HTML element
-type div struct {
+// Source represents the <source> HTML element
+type source struct {
 	// Embeds the base Tag to inherit core HTML element functionality
 	*Tag
 }
 
-// Div creates a new div element
-// Allows optional child nodes to be passed during creation
-func Div(children ...Node) *div {
-	return &div{NewTag("div", false, children)}
+// Source creates a new source element
+// Takes no children since a void element can't have any
+func Source() *source {
+	return &source{NewTag("source", voidElements["source"], nil)}
 }
 
-// Dl represents the <dl> HTML element
-type dl struct {
+// Src sets the "src" attribute
+// Returns the element itself to enable method chaining
+func (e *source) Src(value string) *source {
+	e.Attribute("src", value)
+	return e
+}
+
+// SrcIf conditionally sets the "src" attribute
+// Only sets the attribute if the condition is true
+func (e *source) SrcIf(condition bool, value string) *source {
+	if condition {
+		e.Attribute("src", value)
+	}
+	return e
+}
+
+// Srcset sets the "srcset" attribute
+// Returns the element itself to enable method chaining
+func (e *source) Srcset(value string) *source {
+	e.Attribute("srcset", value)
+	return e
+}
+
+// SrcsetIf conditionally sets the "srcset" attribute
+// Only sets the attribute if the condition is true
+func (e *source) SrcsetIf(condition bool, value string) *source {
+	if condition {
+		e.Attribute("srcset", value)
+	}
+	return e
+}
+
+// Sizes sets the "sizes" attribute
+// Returns the element itself to enable method chaining
+func (e *source) Sizes(value string) *source {
+	e.Attribute("sizes", value)
+	return e
+}
+
+// SizesIf conditionally sets the "sizes" attribute
+// Only sets the attribute if the condition is true
+func (e *source) SizesIf(condition bool, value string) *source {
+	if condition {
+		e.Attribute("sizes", value)
+	}
+	return e
+}
+
+// Type sets the "type" attribute
+// Returns the element itself to enable method chaining
+func (e *source) Type(value string) *source {
+	e.Attribute("type", value)
+	return e
+}
+
+// TypeIf conditionally sets the "type" attribute
+// Only sets the attribute if the condition is true
+func (e *source) TypeIf(condition bool, value string) *source {
+	if condition {
+		e.Attribute("type", value)
+	}
+	return e
+}
+
+// Media sets the "media" attribute
+// Returns the element itself to enable method chaining
+func (e *source) Media(value string) *source {
+	e.Attribute("media", value)
+	return e
+}
+
+// MediaIf conditionally sets the "media" attribute
+// Only sets the attribute if the condition is true
+func (e *source) MediaIf(condition bool, value string) *source {
+	if condition {
+		e.Attribute("media", value)
+	}
+	return e
+}
+
+// Width sets the "width" attribute
+// Returns the element itself to enable method chaining
+func (e *source) Width(value string) *source {
+	e.Attribute("width", value)
+	return e
+}
+
+// WidthIf conditionally sets the "width" attribute
+// Only sets the attribute if the condition is true
+func (e *source) WidthIf(condition bool, value string) *source {
+	if condition {
+		e.Attribute("width", value)
+	}
+	return e
+}
+
+// WidthInt sets the "width" attribute to an integer value
+// Returns the element itself to enable method chaining
+func (e *source) WidthInt(value int) *source {
+	e.AttributeInt("width", value)
+	return e
+}
+
+// Height sets the "height" attribute
+// Returns the element itself to enable method chaining
+func (e *source) Height(value string) *source {
+	e.Attribute("height", value)
+	return e
+}
+
+// HeightIf conditionally sets the "height" attribute
+// Only sets the attribute if the condition is true
+func (e *source) HeightIf(condition bool, value string) *source {
+	if condition {
+		e.Attribute("height", value)
+	}
+	return e
+}
+
+// HeightInt sets the "height" attribute to an integer value
+// Returns the element itself to enable method chaining
+func (e *source) HeightInt(value int) *source {
+	e.AttributeInt("height", value)
+	return e
+}
+
+// Span represents the <span> HTML element
+type span struct {
 	// Embeds the base Tag to inherit core HTML element functionality
 	*Tag
 }
 
-// Dl creates a new dl element
+// Span creates a new span element
 // Allows optional child nodes to be passed during creation
-func Dl(children ...Node) *dl {
-	return &dl{NewTag("dl", false, children)}
+func Span(children ...Node) *span {
+	return &span{NewTag("span", false, children)}
 }
 
-// Dt represents the <dt> HTML element
-type dt struct {
+// Strong represents the <strong> HTML element
+type strong struct {
 	// Embeds the base Tag to inherit core HTML element functionality
 	*Tag
 }
 
-// Dt creates a new dt element
+// Strong creates a new strong element
 // Allows optional child nodes to be passed during creation
-func Dt(children ...Node) *dt {
-	return &dt{NewTag("dt", false, children)}
+func Strong(children ...Node) *strong {
+	return &strong{NewTag("strong", false, children)}
 }
 
-// Em represents the <em> HTML element
-type em struct {
+// Style represents the <style> HTML element
+type style struct {
 	// Embeds the base Tag to inherit core HTML element functionality
 	*Tag
 }
 
-// Em creates a new em element
+// Style creates a new style element
 // Allows optional child nodes to be passed during creation
-func Em(children ...Node) *em {
-	return &em{NewTag("em", false, children)}
+func Style(children ...Node) *style {
+	return &style{NewTag("style", false, children)}
 }
 
-// Embed represents the <embed> HTML element
-type embed struct {
+// Nonce sets the "nonce" attribute used by Content-Security-Policy
+// Returns the element itself to enable method chaining
+func (e *style) Nonce(value string) *style {
+	e.Attribute("nonce", value)
+	return e
+}
+
+// NonceIf conditionally sets the "nonce" attribute
+// Only sets the attribute if the condition is true
+func (e *style) NonceIf(condition bool, value string) *style {
+	if condition {
+		e.Attribute("nonce", value)
+	}
+	return e
+}
+
+// Sub represents the <sub> HTML element
+type sub struct {
 	// Embeds the base Tag to inherit core HTML element functionality
 	*Tag
 }
 
-// Embed creates a new embed element
+// Sub creates a new sub element
 // Allows optional child nodes to be passed during creation
-func Embed(children ...Node) *embed {
-	return &embed{NewTag("embed", true, children)}
+func Sub(children ...Node) *sub {
+	return &sub{NewTag("sub", false, children)}
 }
 
-// Fieldset represents the <fieldset> HTML element
-type fieldset struct {
+// Summary represents the <summary> HTML element
+type summary struct {
 	// Embeds the base Tag to inherit core HTML element functionality
 	*Tag
 }
 
-// Fieldset creates a new fieldset element
+// Summary creates a new summary element
 // Allows optional child nodes to be passed during creation
-func Fieldset(children ...Node) *fieldset {
-	return &fieldset{NewTag("fieldset", false, children)}
+func Summary(children ...Node) *summary {
+	return &summary{NewTag("summary", false, children)}
 }
 
-// Figcaption represents the <figcaption> HTML element
-type figcaption struct {
+// Sup represents the <sup> HTML element
+type sup struct {
 	// Embeds the base Tag to inherit core HTML element functionality
 	*Tag
 }
 
-// Figcaption creates a new figcaption element
+// Sup creates a new sup element
 // Allows optional child nodes to be passed during creation
-func Figcaption(children ...Node) *figcaption {
-	return &figcaption{NewTag("figcaption", false, children)}
+func Sup(children ...Node) *sup {
+	return &sup{NewTag("sup", false, children)}
+}
+
+// svgPresentation provides the SVG presentation attribute setters shared by
+// the SVG elements embedding it, returning the embedding element E
+type svgPresentation[E any] struct {
+	tag  *Tag
+	self E
+}
+
+// Fill sets the "fill" attribute
+// Returns the element itself to enable method chaining
+func (p svgPresentation[E]) Fill(value string) E {
+	p.tag.Attribute("fill", value)
+	return p.self
+}
+
+// FillIf conditionally sets the "fill" attribute
+// Only sets the attribute if the condition is true
+func (p svgPresentation[E]) FillIf(condition bool, value string) E {
+	if condition {
+		p.tag.Attribute("fill", value)
+	}
+	return p.self
+}
+
+// Stroke sets the "stroke" attribute
+// Returns the element itself to enable method chaining
+func (p svgPresentation[E]) Stroke(value string) E {
+	p.tag.Attribute("stroke", value)
+	return p.self
 }
 
-// Figure represents the <figure> HTML element
-type figure struct {
-	// Embeds the base Tag to inherit core HTML element functionality
-	*Tag
+// StrokeIf conditionally sets the "stroke" attribute
+// Only sets the attribute if the condition is true
+func (p svgPresentation[E]) StrokeIf(condition bool, value string) E {
+	if condition {
+		p.tag.Attribute("stroke", value)
+	}
+	return p.self
 }
 
-// Figure creates a new figure element
-// Allows optional child nodes to be passed during creation
-func Figure(children ...Node) *figure {
-	return &figure{NewTag("figure", false, children)}
+// StrokeWidth sets the "stroke-width" attribute
+// Returns the element itself to enable method chaining
+func (p svgPresentation[E]) StrokeWidth(value string) E {
+	p.tag.Attribute("stroke-width", value)
+	return p.self
 }
 
-// Footer represents the <footer> HTML element
-type footer struct {
-	// Embeds the base Tag to inherit core HTML element functionality
-	*Tag
+// StrokeWidthIf conditionally sets the "stroke-width" attribute
+// Only sets the attribute if the condition is true
+func (p svgPresentation[E]) StrokeWidthIf(condition bool, value string) E {
+	if condition {
+		p.tag.Attribute("stroke-width", value)
+	}
+	return p.self
 }
 
-// Footer creates a new footer element
-// Allows optional child nodes to be passed during creation
-func Footer(children ...Node) *footer {
-	return &footer{NewTag("footer", false, children)}
+// StrokeLinecap sets the "stroke-linecap" attribute
+// Returns the element itself to enable method chaining
+func (p svgPresentation[E]) StrokeLinecap(value string) E {
+	p.tag.Attribute("stroke-linecap", value)
+	return p.self
 }
 
-// Form represents the <form> HTML element
-type form struct {
-	// Embeds the base Tag to inherit core HTML element functionality
-	*Tag
+// StrokeLinecapIf conditionally sets the "stroke-linecap" attribute
+// Only sets the attribute if the condition is true
+func (p svgPresentation[E]) StrokeLinecapIf(condition bool, value string) E {
+	if condition {
+		p.tag.Attribute("stroke-linecap", value)
+	}
+	return p.self
 }
 
-// Form creates a new form element
-// Allows optional child nodes to be passed during creation
-func Form(children ...Node) *form {
-	return &form{NewTag("form", false, children)}
+// StrokeLinejoin sets the "stroke-linejoin" attribute
+// Returns the element itself to enable method chaining
+func (p svgPresentation[E]) StrokeLinejoin(value string) E {
+	p.tag.Attribute("stroke-linejoin", value)
+	return p.self
 }
 
-// Id sets the "id" attribute
+// StrokeLinejoinIf conditionally sets the "stroke-linejoin" attribute
+// Only sets the attribute if the condition is true
+func (p svgPresentation[E]) StrokeLinejoinIf(condition bool, value string) E {
+	if condition {
+		p.tag.Attribute("stroke-linejoin", value)
+	}
+	return p.self
+}
+
+// StrokeDasharray sets the "stroke-dasharray" attribute
 // Returns the element itself to enable method chaining
-func (e *form) Id(value string) *form {
-	e.Attribute("id", value)
-	return e
+func (p svgPresentation[E]) StrokeDasharray(value string) E {
+	p.tag.Attribute("stroke-dasharray", value)
+	return p.self
 }
 
-// IdIf conditionally sets the "id" attribute
+// StrokeDasharrayIf conditionally sets the "stroke-dasharray" attribute
 // Only sets the attribute if the condition is true
-func (e *form) IdIf(condition bool, value string) *form {
+func (p svgPresentation[E]) StrokeDasharrayIf(condition bool, value string) E {
 	if condition {
-		e.Attribute("id", value)
+		p.tag.Attribute("stroke-dasharray", value)
 	}
-	return e
+	return p.self
 }
 
-// Action sets the "action" attribute
+// Opacity sets the "opacity" attribute
 // Returns the element itself to enable method chaining
-func (e *form) Action(value string) *form {
-	e.Attribute("action", value)
-	return e
+func (p svgPresentation[E]) Opacity(value string) E {
+	p.tag.Attribute("opacity", value)
+	return p.self
 }
 
-// ActionIf conditionally sets the "action" attribute
+// OpacityIf conditionally sets the "opacity" attribute
 // Only sets the attribute if the condition is true
-func (e *form) ActionIf(condition bool, value string) *form {
+func (p svgPresentation[E]) OpacityIf(condition bool, value string) E {
 	if condition {
-		e.Attribute("action", value)
+		p.tag.Attribute("opacity", value)
 	}
-	return e
+	return p.self
 }
 
-// Method sets the "method" attribute
+// FillOpacity sets the "fill-opacity" attribute
 // Returns the element itself to enable method chaining
-func (e *form) Method(value string) *form {
-	e.Attribute("method", value)
-	return e
+func (p svgPresentation[E]) FillOpacity(value string) E {
+	p.tag.Attribute("fill-opacity", value)
+	return p.self
 }
 
-// MethodIf conditionally sets the "method" attribute
+// FillOpacityIf conditionally sets the "fill-opacity" attribute
 // Only sets the attribute if the condition is true
-func (e *form) MethodIf(condition bool, value string) *form {
+func (p svgPresentation[E]) FillOpacityIf(condition bool, value string) E {
 	if condition {
-		e.Attribute("method", value)
+		p.tag.Attribute("fill-opacity", value)
 	}
-	return e
+	return p.self
 }
 
-// Enctype sets the "enctype" attribute
+// Transform sets the "transform" attribute
 // Returns the element itself to enable method chaining
-func (e *form) Enctype(value string) *form {
-	e.Attribute("enctype", value)
-	return e
+func (p svgPresentation[E]) Transform(value string) E {
+	p.tag.Attribute("transform", value)
+	return p.self
 }
 
-// EnctypeIf conditionally sets the "enctype" attribute
+// TransformIf conditionally sets the "transform" attribute
 // Only sets the attribute if the condition is true
-func (e *form) EnctypeIf(condition bool, value string) *form {
+func (p svgPresentation[E]) TransformIf(condition bool, value string) E {
 	if condition {
-		e.Attribute("enctype", value)
+		p.tag.Attribute("transform", value)
 	}
+	return p.self
+}
+
+// svg represents the <svg> HTML element
+type svg struct {
+	// Embeds the base Tag to inherit core HTML element functionality
+	*Tag
+
+	// Embeds the shared SVG presentation attribute setters
+	svgPresentation[*svg]
+}
+
+// SVG creates a new svg element
+// Allows optional child nodes to be passed during creation
+func SVG(children ...Node) *svg {
+	e := &svg{Tag: NewTag("svg", false, children)}
+	e.svgPresentation = svgPresentation[*svg]{tag: e.Tag, self: e}
 	return e
 }
 
-// Novalidate sets the "novalidate" attribute
+// ViewBox sets the "viewBox" attribute
 // Returns the element itself to enable method chaining
-func (e *form) Novalidate(value string) *form {
-	e.Attribute("novalidate", value)
+func (e *svg) ViewBox(value string) *svg {
+	e.Attribute("viewBox", value)
 	return e
 }
 
-// NovalidateIf conditionally sets the "novalidate" attribute
+// ViewBoxIf conditionally sets the "viewBox" attribute
 // Only sets the attribute if the condition is true
-func (e *form) NovalidateIf(condition bool, value string) *form {
+func (e *svg) ViewBoxIf(condition bool, value string) *svg {
 	if condition {
-		e.Attribute("novalidate", value)
+		e.Attribute("viewBox", value)
 	}
 	return e
 }
 
-// Autocomplete sets the "autocomplete" attribute
+// PreserveAspectRatio sets the "preserveAspectRatio" attribute
 // Returns the element itself to enable method chaining
-func (e *form) Autocomplete(value string) *form {
-	e.Attribute("autocomplete", value)
+func (e *svg) PreserveAspectRatio(value string) *svg {
+	e.Attribute("preserveAspectRatio", value)
 	return e
 }
 
-// AutocompleteIf conditionally sets the "autocomplete" attribute
+// PreserveAspectRatioIf conditionally sets the "preserveAspectRatio" attribute
 // Only sets the attribute if the condition is true
-func (e *form) AutocompleteIf(condition bool, value string) *form {
+func (e *svg) PreserveAspectRatioIf(condition bool, value string) *svg {
 	if condition {
-		e.Attribute("autocomplete", value)
+		e.Attribute("preserveAspectRatio", value)
 	}
 	return e
 }
 
-// H1 represents the <h1> HTML element
-type h1 struct {
-	// Embeds the base Tag to inherit core HTML element functionality
-	*Tag
+// Xmlns sets the "xmlns" attribute
+// Returns the element itself to enable method chaining
+func (e *svg) Xmlns(value string) *svg {
+	e.Attribute("xmlns", value)
+	return e
 }
 
-// H1 creates a new h1 element
-// Allows optional child nodes to be passed during creation
-func H1(children ...Node) *h1 {
-	return &h1{NewTag("h1", false, children)}
+// XmlnsIf conditionally sets the "xmlns" attribute
+// Only sets the attribute if the condition is true
+func (e *svg) XmlnsIf(condition bool, value string) *svg {
+	if condition {
+		e.Attribute("xmlns", value)
+	}
+	return e
 }
 
-// H2 represents the <h2> HTML element
-type h2 struct {
-	// Embeds the base Tag to inherit core HTML element functionality
-	*Tag
+// Version sets the "version" attribute
+// Returns the element itself to enable method chaining
+func (e *svg) Version(value string) *svg {
+	e.Attribute("version", value)
+	return e
 }
 
-// H2 creates a new h2 element
-// Allows optional child nodes to be passed during creation
-func H2(children ...Node) *h2 {
-	return &h2{NewTag("h2", false, children)}
+// VersionIf conditionally sets the "version" attribute
+// Only sets the attribute if the condition is true
+func (e *svg) VersionIf(condition bool, value string) *svg {
+	if condition {
+		e.Attribute("version", value)
+	}
+	return e
 }
 
-// H3 represents the <h3> HTML element
-type h3 struct {
+// Table represents the <table> HTML element
+type table struct {
 	// Embeds the base Tag to inherit core HTML element functionality
 	*Tag
 }
 
-// H3 creates a new h3 element
+// Table creates a new table element
 // Allows optional child nodes to be passed during creation
-func H3(children ...Node) *h3 {
-	return &h3{NewTag("h3", false, children)}
+func Table(children ...Node) *table {
+	return &table{NewTag("table", false, children)}
 }
 
-// H4 represents the <h4> HTML element
-type h4 struct {
-	// Embeds the base Tag to inherit core HTML element functionality
-	*Tag
+// Border sets the "border" attribute
+// Returns the element itself to enable method chaining
+func (e *table) Border(value string) *table {
+	e.Attribute("border", value)
+	return e
 }
 
-// H4 creates a new h4 element
-// Allows optional child nodes to be passed during creation
-func H4(children ...Node) *h4 {
-	return &h4{NewTag("h4", false, children)}
+// BorderIf conditionally sets the "border" attribute
+// Only sets the attribute if the condition is true
+func (e *table) BorderIf(condition bool, value string) *table {
+	if condition {
+		e.Attribute("border", value)
+	}
+	return e
 }
 
-// H5 represents the <h5> HTML element
-type h5 struct {
-	// Embeds the base Tag to inherit core HTML element functionality
-	*Tag
+// Cellpadding sets the "cellpadding" attribute
+// Returns the element itself to enable method chaining
+func (e *table) Cellpadding(value string) *table {
+	e.Attribute("cellpadding", value)
+	return e
 }
 
-// H5 creates a new h5 element
-// Allows optional child nodes to be passed during creation
-func H5(children ...Node) *h5 {
-	return &h5{NewTag("h5", false, children)}
+// CellpaddingIf conditionally sets the "cellpadding" attribute
+// Only sets the attribute if the condition is true
+func (e *table) CellpaddingIf(condition bool, value string) *table {
+	if condition {
+		e.Attribute("cellpadding", value)
+	}
+	return e
 }
 
-// H6 represents the <h6> HTML element
-type h6 struct {
-	// Embeds the base Tag to inherit core HTML element functionality
-	*Tag
+// Cellspacing sets the "cellspacing" attribute
+// Returns the element itself to enable method chaining
+func (e *table) Cellspacing(value string) *table {
+	e.Attribute("cellspacing", value)
+	return e
 }
 
-// H6 creates a new h6 element
-// Allows optional child nodes to be passed during creation
-func H6(children ...Node) *h6 {
-	return &h6{NewTag("h6", false, children)}
+// CellspacingIf conditionally sets the "cellspacing" attribute
+// Only sets the attribute if the condition is true
+func (e *table) CellspacingIf(condition bool, value string) *table {
+	if condition {
+		e.Attribute("cellspacing", value)
+	}
+	return e
 }
 
-// Head represents the <head> HTML element
-type head struct {
+// Tbody represents the <tbody> HTML element
+type tbody struct {
 	// Embeds the base Tag to inherit core HTML element functionality
 	*Tag
 }
 
-// Head creates a new head element
+// Tbody creates a new tbody element
 // Allows optional child nodes to be passed during creation
-func Head(children ...Node) *head {
-	return &head{NewTag("head", false, children)}
+func Tbody(children ...Node) *tbody {
+	return &tbody{NewTag("tbody", false, children)}
 }
 
-// Header represents the <header> HTML element
-type header struct {
+// Td represents the <td> HTML element
+type td struct {
 	// Embeds the base Tag to inherit core HTML element functionality
 	*Tag
 }
 
-// Header creates a new header element
+// Td creates a new td element
 // Allows optional child nodes to be passed during creation
-func Header(children ...Node) *header {
-	return &header{NewTag("header", false, children)}
+func Td(children ...Node) *td {
+	return &td{NewTag("td", false, children)}
 }
 
-// Hr represents the <hr> HTML element
-type hr struct {
-	// Embeds the base Tag to inherit core HTML element functionality
-	*Tag
+// Colspan sets the "colspan" attribute
+// Returns the element itself to enable method chaining
+func (e *td) Colspan(value string) *td {
+	e.Attribute("colspan", value)
+	return e
 }
 
-// Hr creates a new hr element
-// Allows optional child nodes to be passed during creation
-func Hr(children ...Node) *hr {
-	return &hr{NewTag("hr", true, children)}
+// ColspanIf conditionally sets the "colspan" attribute
+// Only sets the attribute if the condition is true
+func (e *td) ColspanIf(condition bool, value string) *td {
+	if condition {
+		e.Attribute("colspan", value)
+	}
+	return e
 }
 
-// Html represents the <html> HTML element
-type html_ struct {
-	// Embeds the base Tag to inherit core HTML element functionality
-	*Tag
+// ColspanInt sets the "colspan" attribute to an integer value
+// Returns the element itself to enable method chaining
+func (e *td) ColspanInt(value int) *td {
+	e.AttributeInt("colspan", value)
+	return e
 }
 
-// Lang sets the "lang" attribute
+// Rowspan sets the "rowspan" attribute
 // Returns the element itself to enable method chaining
-func (e *html_) Lang(value string) *html_ {
-	e.Attribute("lang", value)
+func (e *td) Rowspan(value string) *td {
+	e.Attribute("rowspan", value)
 	return e
 }
 
-// LangIf conditionally sets the "lang" attribute
+// RowspanIf conditionally sets the "rowspan" attribute
 // Only sets the attribute if the condition is true
-func (e *html_) LangIf(condition bool, value string) *html_ {
+func (e *td) RowspanIf(condition bool, value string) *td {
 	if condition {
-		e.Attribute("lang", value)
+		e.Attribute("rowspan", value)
 	}
 	return e
 }
 
-// Class sets the "class" attribute
+// RowspanInt sets the "rowspan" attribute to an integer value
 // Returns the element itself to enable method chaining
-func (e *html_) Classes(values ...string) *html_ {
-	e.Attribute("class", strings.Join(values, " "))
+func (e *td) RowspanInt(value int) *td {
+	e.AttributeInt("rowspan", value)
 	return e
 }
 
-// ClassIf conditionally sets the "class" attribute
+// Headers sets the "headers" attribute
+// Returns the element itself to enable method chaining
+func (e *td) Headers(value string) *td {
+	e.Attribute("headers", value)
+	return e
+}
+
+// HeadersIf conditionally sets the "headers" attribute
 // Only sets the attribute if the condition is true
-func (e *html_) ClassIf(condition bool, value string) *html_ {
+func (e *td) HeadersIf(condition bool, value string) *td {
 	if condition {
-		e.Attribute("class", value)
+		e.Attribute("headers", value)
 	}
 	return e
 }
 
-// HTML creates a new html element
-// Allows optional child nodes to be passed during creation
-func HTML(children ...Node) *html_ {
-	return &html_{NewTag("html", false, children)}
-}
-
-// I represents the <i> HTML element
-type i struct {
+// Template represents the <template> HTML element
+type template struct {
 	// Embeds the base Tag to inherit core HTML element functionality
 	*Tag
 }
 
-// I creates a new i element
+// Template creates a new template element
 // Allows optional child nodes to be passed during creation
-func I(children ...Node) *i {
-	return &i{NewTag("i", false, children)}
+func Template(children ...Node) *template {
+	return &template{NewTag("template", false, children)}
 }
 
-// Iframe represents the <iframe> HTML element
-type iframe struct {
+// Textarea represents the <textarea> HTML element
+type textarea struct {
 	// Embeds the base Tag to inherit core HTML element functionality
 	*Tag
 }
 
-// Iframe creates a new iframe element
+// Textarea creates a new textarea element
 // Allows optional child nodes to be passed during creation
-func Iframe(children ...Node) *iframe {
-	return &iframe{NewTag("iframe", false, children)}
+func Textarea(children ...Node) *textarea {
+	return &textarea{NewTag("textarea", false, children)}
 }
 
-// Allow sets the "allow" attribute
+// Value sets the initial value of the textarea as its escaped text content,
+// replacing any children. Browsers drop a newline following the opening tag,
+// so one is added when the value starts with a newline to keep it intact
 // Returns the element itself to enable method chaining
-func (e *iframe) Allow(value string) *iframe {
-	e.Attribute("allow", value)
+func (e *textarea) Value(v string) *textarea {
+	if strings.HasPrefix(v, "\n") {
+		v = "\n" + v
+	}
+	e.children = []Node{Text(v)}
 	return e
 }
 
-// AllowIf conditionally sets the "allow" attribute
+// Rows sets the "rows" attribute
+// Returns the element itself to enable method chaining
+func (e *textarea) Rows(value string) *textarea {
+	e.Attribute("rows", value)
+	return e
+}
+
+// RowsIf conditionally sets the "rows" attribute
 // Only sets the attribute if the condition is true
-func (e *iframe) AllowIf(condition bool, value string) *iframe {
+func (e *textarea) RowsIf(condition bool, value string) *textarea {
 	if condition {
-		e.Attribute("allow", value)
+		e.Attribute("rows", value)
 	}
 	return e
 }
 
-// Allowfullscreen sets the "allowfullscreen" attribute
+// RowsInt sets the "rows" attribute to an integer value
 // Returns the element itself to enable method chaining
-func (e *iframe) Allowfullscreen(value string) *iframe {
-	e.Attribute("allowfullscreen", value)
+func (e *textarea) RowsInt(value int) *textarea {
+	e.AttributeInt("rows", value)
 	return e
 }
 
-// AllowfullscreenIf conditionally sets the "allowfullscreen" attribute
+// Cols sets the "cols" attribute
+// Returns the element itself to enable method chaining
+func (e *textarea) Cols(value string) *textarea {
+	e.Attribute("cols", value)
+	return e
+}
+
+// ColsIf conditionally sets the "cols" attribute
 // Only sets the attribute if the condition is true
-func (e *iframe) AllowfullscreenIf(condition bool, value string) *iframe {
+func (e *textarea) ColsIf(condition bool, value string) *textarea {
 	if condition {
-		e.Attribute("allowfullscreen", value)
+		e.Attribute("cols", value)
 	}
 	return e
 }
 
-// Sandbox sets the "sandbox" attribute
+// ColsInt sets the "cols" attribute to an integer value
 // Returns the element itself to enable method chaining
-func (e *iframe) Sandbox(value string) *iframe {
-	e.Attribute("sandbox", value)
+func (e *textarea) ColsInt(value int) *textarea {
+	e.AttributeInt("cols", value)
 	return e
 }
 
-// SandboxIf conditionally sets the "sandbox" attribute
+// Maxlength sets the "maxlength" attribute
+// Returns the element itself to enable method chaining
+func (e *textarea) Maxlength(value string) *textarea {
+	e.Attribute("maxlength", value)
+	return e
+}
+
+// MaxlengthIf conditionally sets the "maxlength" attribute
 // Only sets the attribute if the condition is true
-func (e *iframe) SandboxIf(condition bool, value string) *iframe {
+func (e *textarea) MaxlengthIf(condition bool, value string) *textarea {
 	if condition {
-		e.Attribute("sandbox", value)
+		e.Attribute("maxlength", value)
 	}
 	return e
 }
 
-// Srcdoc sets the "srcdoc" attribute
+// Minlength sets the "minlength" attribute
 // Returns the element itself to enable method chaining
-func (e *iframe) Srcdoc(value string) *iframe {
-	e.Attribute("srcdoc", value)
+func (e *textarea) Minlength(value string) *textarea {
+	e.Attribute("minlength", value)
 	return e
 }
 
-// SrcdocIf conditionally sets the "srcdoc" attribute
+// MinlengthIf conditionally sets the "minlength" attribute
 // Only sets the attribute if the condition is true
-func (e *iframe) SrcdocIf(condition bool, value string) *iframe {
+func (e *textarea) MinlengthIf(condition bool, value string) *textarea {
 	if condition {
-		e.Attribute("srcdoc", value)
+		e.Attribute("minlength", value)
 	}
 	return e
 }
 
-// Loading sets the "loading" attribute
+// Wrap sets the "wrap" attribute
 // Returns the element itself to enable method chaining
-func (e *iframe) Loading(value string) *iframe {
-	e.Attribute("loading", value)
+func (e *textarea) Wrap(value string) *textarea {
+	e.Attribute("wrap", value)
 	return e
 }
 
-// LoadingIf conditionally sets the "loading" attribute
+// WrapIf conditionally sets the "wrap" attribute
 // Only sets the attribute if the condition is true
-func (e *iframe) LoadingIf(condition bool, value string) *iframe {
+func (e *textarea) WrapIf(condition bool, value string) *textarea {
 	if condition {
-		e.Attribute("loading", value)
+		e.Attribute("wrap", value)
 	}
 	return e
 }
 
-// Img represents the <img> HTML element
-type img struct {
+// Tfoot represents the <tfoot> HTML element
+type tfoot struct {
 	// Embeds the base Tag to inherit core HTML element functionality
 	*Tag
 }
 
-// Img creates a new img element
+// Tfoot creates a new tfoot element
 // Allows optional child nodes to be passed during creation
-func Img(children ...Node) *img {
-	return &img{NewTag("img", true, children)}
+func Tfoot(children ...Node) *tfoot {
+	return &tfoot{NewTag("tfoot", false, children)}
 }
 
-// Srcset sets the "srcset" attribute
-// Returns the element itself to enable method chaining
-func (e *img) Srcset(value string) *img {
-	e.Attribute("srcset", value)
-	return e
+// Th represents the <th> HTML element
+type th struct {
+	// Embeds the base Tag to inherit core HTML element functionality
+	*Tag
 }
 
-// SrcsetIf conditionally sets the "srcset" attribute
-// Only sets the attribute if the condition is true
-func (e *img) SrcsetIf(condition bool, value string) *img {
-	if condition {
-		e.Attribute("srcset", value)
-	}
-	return e
+// Th creates a new th element
+// Allows optional child nodes to be passed during creation
+func Th(children ...Node) *th {
+	return &th{NewTag("th", false, children)}
 }
 
-// Sizes sets the "sizes" attribute
+// Scope sets the "scope" attribute
 // Returns the element itself to enable method chaining
-func (e *img) Sizes(value string) *img {
-	e.Attribute("sizes", value)
+func (e *th) Scope(value string) *th {
+	e.Attribute("scope", value)
 	return e
 }
 
-// SizesIf conditionally sets the "sizes" attribute
+// ScopeIf conditionally sets the "scope" attribute
 // Only sets the attribute if the condition is true
-func (e *img) SizesIf(condition bool, value string) *img {
+func (e *th) ScopeIf(condition bool, value string) *th {
 	if condition {
-		e.Attribute("sizes", value)
+		e.Attribute("scope", value)
 	}
 	return e
 }
 
-// Crossorigin sets the "crossorigin" attribute
-// Returns the element itself to enable method chaining
-func (e *img) Crossorigin(value string) *img {
-	e.Attribute("crossorigin", value)
-	return e
+// Thead represents the <thead> HTML element
+type thead struct {
+	// Embeds the base Tag to inherit core HTML element functionality
+	*Tag
 }
 
-// CrossoriginIf conditionally sets the "crossorigin" attribute
-// Only sets the attribute if the condition is true
-func (e *img) CrossoriginIf(condition bool, value string) *img {
-	if condition {
-		e.Attribute("crossorigin", value)
-	}
-	return e
+// Thead creates a new thead element
+// Allows optional child nodes to be passed during creation
+func Thead(children ...Node) *thead {
+	return &thead{NewTag("thead", false, children)}
 }
 
-// Decoding sets the "decoding" attribute
+// Time represents the <time> HTML element
+type time struct {
+	// Embeds the base Tag to inherit core HTML element functionality
+	*Tag
+}
+
+// Time creates a new time element
+// Allows optional child nodes to be passed during creation
+func Time(children ...Node) *time {
+	return &time{NewTag("time", false, children)}
+}
+
+// Datetime sets the "datetime" attribute
 // Returns the element itself to enable method chaining
-func (e *img) Decoding(value string) *img {
-	e.Attribute("decoding", value)
+func (e *time) Datetime(value string) *time {
+	e.Attribute("datetime", value)
 	return e
 }
 
-// DecodingIf conditionally sets the "decoding" attribute
+// DatetimeIf conditionally sets the "datetime" attribute
 // Only sets the attribute if the condition is true
-func (e *img) DecodingIf(condition bool, value string) *img {
+func (e *time) DatetimeIf(condition bool, value string) *time {
 	if condition {
-		e.Attribute("decoding", value)
+		e.Attribute("datetime", value)
 	}
 	return e
 }
 
-// Ismap sets the "ismap" attribute
-// Returns the element itself to enable method chaining
-func (e *img) Ismap(value string) *img {
-	e.Attribute("ismap", value)
-	return e
+// Title represents the <title> HTML element
+type title struct {
+	// Embeds the base Tag to inherit core HTML element functionality
+	*Tag
+}
+
+// Title creates a new title element
+// Allows optional child nodes to be passed during creation
+func Title(children ...Node) *title {
+	return &title{NewTag("title", false, children)}
+}
+
+// Tr represents the <tr> HTML element
+type tr struct {
+	// Embeds the base Tag to inherit core HTML element functionality
+	*Tag
+}
+
+// Tr creates a new tr element
+// Allows optional child nodes to be passed during creation
+func Tr(children ...Node) *tr {
+	return &tr{NewTag("tr", false, children)}
+}
+
+// Track represents the <track> HTML element
+type track struct {
+	// Embeds the base Tag to inherit core HTML element functionality
+	*Tag
+}
+
+// Track creates a new track element
+// Takes no children since a void element can't have any
+func Track() *track {
+	return &track{NewTag("track", voidElements["track"], nil)}
+}
+
+// U represents the <u> HTML element
+type u struct {
+	// Embeds the base Tag to inherit core HTML element functionality
+	*Tag
 }
 
-// IsmapIf conditionally sets the "ismap" attribute
-// Only sets the attribute if the condition is true
-func (e *img) IsmapIf(condition bool, value string) *img {
-	if condition {
-		e.Attribute("ismap", value)
-	}
-	return e
+// U creates a new u element
+// Allows optional child nodes to be passed during creation
+func U(children ...Node) *u {
+	return &u{NewTag("u", false, children)}
 }
 
-// Usemap sets the "usemap" attribute
-// Returns the element itself to enable method chaining
-func (e *img) Usemap(value string) *img {
-	e.Attribute("usemap", value)
-	return e
+// Ul represents the <ul> HTML element
+type ul struct {
+	// Embeds the base Tag to inherit core HTML element functionality
+	*Tag
 }
 
-// UsemapIf conditionally sets the "usemap" attribute
-// Only sets the attribute if the condition is true
-func (e *img) UsemapIf(condition bool, value string) *img {
-	if condition {
-		e.Attribute("usemap", value)
-	}
-	return e
+// Ul creates a new ul element
+// Allows optional child nodes to be passed during creation
+func Ul(children ...Node) *ul {
+	return &ul{NewTag("ul", false, children)}
 }
 
-// Input represents the <input> HTML element
-type input struct {
+// Var represents the <var> HTML element
+type var_ struct {
 	// Embeds the base Tag to inherit core HTML element functionality
 	*Tag
 }
 
-// Input creates a new input element
+// Var creates a new var element
 // Allows optional child nodes to be passed during creation
-func Input(children ...Node) *input {
-	return &input{NewTag("input", true, children)}
+func Var(children ...Node) *var_ {
+	return &var_{NewTag("var", false, children)}
 }
 
-// Name sets the "name" attribute
-// Returns the element itself to enable method chaining
-func (e *input) Name(values ...string) *input {
-	e.Attribute("name", strings.Join(values, " "))
-	return e
+// Video represents the <video> HTML element
+type video struct {
+	// Embeds the base Tag to inherit core HTML element functionality
+	*Tag
 }
 
-// NameIf conditionally sets the "name" attribute
-// Only sets the attribute if the condition is true
-func (e *input) NameIf(condition bool, value string) *input {
-	e.Attribute("name", value)
-	return e
+// Video creates a new video element
+// Allows optional child nodes to be passed during creation
+func Video(children ...Node) *video {
+	return &video{NewTag("video", false, children)}
 }
 
-// Id sets the "id" attribute
+// Poster sets the "poster" attribute
 // Returns the element itself to enable method chaining
-func (e *input) Id(values ...string) *input {
-	e.Attribute("id", strings.Join(values, " "))
+func (e *video) Poster(value string) *video {
+	e.Attribute("poster", value)
 	return e
 }
 
-// IdIf conditionally sets the "id" attribute
+// PosterIf conditionally sets the "poster" attribute
 // Only sets the attribute if the condition is true
-func (e *input) IdIf(condition bool, value string) *input {
-	e.Attribute("id", value)
+func (e *video) PosterIf(condition bool, value string) *video {
+	if condition {
+		e.Attribute("poster", value)
+	}
 	return e
 }
 
-// Type sets the "type" attribute
+// Src sets the "src" attribute
 // Returns the element itself to enable method chaining
-func (e *input) Type(value string) *input {
-	e.Attribute("type", value)
+func (e *video) Src(value string) *video {
+	e.Attribute("src", value)
 	return e
 }
 
-// TypeIf conditionally sets the "type" attribute
+// SrcIf conditionally sets the "src" attribute
 // Only sets the attribute if the condition is true
-func (e *input) TypeIf(condition bool, value string) *input {
+func (e *video) SrcIf(condition bool, value string) *video {
 	if condition {
-		e.Attribute("type", value)
+		e.Attribute("src", value)
 	}
 	return e
 }
 
-// Placeholder sets the "placeholder" attribute
+// Width sets the "width" attribute
 // Returns the element itself to enable method chaining
-func (e *input) Placeholder(value string) *input {
-	e.Attribute("placeholder", value)
+func (e *video) Width(value string) *video {
+	e.Attribute("width", value)
 	return e
 }
 
-// PlaceholderIf conditionally sets the "placeholder" attribute
+// WidthIf conditionally sets the "width" attribute
 // Only sets the attribute if the condition is true
-func (e *input) PlaceholderIf(condition bool, value string) *input {
+func (e *video) WidthIf(condition bool, value string) *video {
 	if condition {
-		e.Attribute("placeholder", value)
+		e.Attribute("width", value)
 	}
 	return e
 }
 
-// Required sets the "required" attribute
+// WidthInt sets the "width" attribute to an integer value
 // Returns the element itself to enable method chaining
-func (e *input) Required(value string) *input {
-	e.Attribute("required", value)
+func (e *video) WidthInt(value int) *video {
+	e.AttributeInt("width", value)
 	return e
 }
 
-// RequiredIf conditionally sets the "required" attribute
+// Height sets the "height" attribute
+// Returns the element itself to enable method chaining
+func (e *video) Height(value string) *video {
+	e.Attribute("height", value)
+	return e
+}
+
+// HeightIf conditionally sets the "height" attribute
 // Only sets the attribute if the condition is true
-func (e *input) RequiredIf(condition bool, value string) *input {
+func (e *video) HeightIf(condition bool, value string) *video {
 	if condition {
-		e.Attribute("required", value)
+		e.Attribute("height", value)
 	}
 	return e
 }
 
-// Readonly sets the "readonly" attribute
+// HeightInt sets the "height" attribute to an integer value
 // Returns the element itself to enable method chaining
-func (e *input) Readonly(value string) *input {
-	e.Attribute("readonly", value)
+func (e *video) HeightInt(value int) *video {
+	e.AttributeInt("height", value)
 	return e
 }
 
-// ReadonlyIf conditionally sets the "readonly" attribute
+// Controls sets the "controls" attribute
+// Returns the element itself to enable method chaining
+func (e *video) Controls(value string) *video {
+	e.Attribute("controls", value)
+	return e
+}
+
+// ControlsIf conditionally sets the "controls" attribute
 // Only sets the attribute if the condition is true
-func (e *input) ReadonlyIf(condition bool, value string) *input {
+func (e *video) ControlsIf(condition bool, value string) *video {
 	if condition {
-		e.Attribute("readonly", value)
+		e.Attribute("controls", value)
 	}
 	return e
 }
 
-// Checked sets the "checked" attribute
+// Autoplay sets the "autoplay" attribute
 // Returns the element itself to enable method chaining
-func (e *input) Checked(value string) *input {
-	e.Attribute("checked", value)
+func (e *video) Autoplay(value string) *video {
+	e.Attribute("autoplay", value)
 	return e
 }
 
-// CheckedIf conditionally sets the "checked" attribute
+// AutoplayIf conditionally sets the "autoplay" attribute
 // Only sets the attribute if the condition is true
-func (e *input) CheckedIf(condition bool, value string) *input {
+func (e *video) AutoplayIf(condition bool, value string) *video {
 	if condition {
-		e.Attribute("checked", value)
+		e.Attribute("autoplay", value)
 	}
 	return e
 }
 
-// Min sets the "min" attribute
+// Loop sets the "loop" attribute
 // Returns the element itself to enable method chaining
-func (e *input) Min(value string) *input {
-	e.Attribute("min", value)
+func (e *video) Loop(value string) *video {
+	e.Attribute("loop", value)
 	return e
 }
 
-// MinIf conditionally sets the "min" attribute
+// LoopIf conditionally sets the "loop" attribute
 // Only sets the attribute if the condition is true
-func (e *input) MinIf(condition bool, value string) *input {
+func (e *video) LoopIf(condition bool, value string) *video {
 	if condition {
-		e.Attribute("min", value)
+		e.Attribute("loop", value)
 	}
 	return e
 }
 
-// Max sets the "max" attribute
+// Muted sets the "muted" attribute
 // Returns the element itself to enable method chaining
-func (e *input) Max(value string) *input {
-	e.Attribute("max", value)
+func (e *video) Muted(value string) *video {
+	e.Attribute("muted", value)
 	return e
 }
 
-// MaxIf conditionally sets the "max" attribute
+// MutedIf conditionally sets the "muted" attribute
 // Only sets the attribute if the condition is true
-func (e *input) MaxIf(condition bool, value string) *input {
+func (e *video) MutedIf(condition bool, value string) *video {
 	if condition {
-		e.Attribute("max", value)
+		e.Attribute("muted", value)
 	}
 	return e
 }
 
-// Pattern sets the "pattern" attribute
+// Preload sets the "preload" attribute
 // Returns the element itself to enable method chaining
-func (e *input) Pattern(value string) *input {
-	e.Attribute("pattern", value)
+func (e *video) Preload(value string) *video {
+	e.Attribute("preload", value)
 	return e
 }
 
-// PatternIf conditionally sets the "pattern" attribute
+// PreloadIf conditionally sets the "preload" attribute
 // Only sets the attribute if the condition is true
-func (e *input) PatternIf(condition bool, value string) *input {
+func (e *video) PreloadIf(condition bool, value string) *video {
 	if condition {
-		e.Attribute("pattern", value)
+		e.Attribute("preload", value)
 	}
 	return e
 }
 
-// Ins represents the <ins> HTML element
-type ins struct {
-	// Embeds the base Tag to inherit core HTML element functionality
-	*Tag
-}
-
-// Ins creates a new ins element
-// Allows optional child nodes to be passed during creation
-func Ins(children ...Node) *ins {
-	return &ins{NewTag("ins", false, children)}
-}
-
-// Kbd represents the <kbd> HTML element
-type kbd struct {
-	// Embeds the base Tag to inherit core HTML element functionality
-	*Tag
-}
-
-// Kbd creates a new kbd element
-// Allows optional child nodes to be passed during creation
-func Kbd(children ...Node) *kbd {
-	return &kbd{NewTag("kbd", false, children)}
-}
-
-// Label represents the <label> HTML element
-type label struct {
-	// Embeds the base Tag to inherit core HTML element functionality
-	*Tag
-}
-
-// Label creates a new label element
-// Allows optional child nodes to be passed during creation
-func Label(children ...Node) *label {
-	return &label{NewTag("label", false, children)}
-}
-
-// For sets the "for" attribute
+// Playsinline sets the "playsinline" attribute
 // Returns the element itself to enable method chaining
-func (e *label) For(value string) *label {
-	e.Attribute("for", value)
+func (e *video) Playsinline(value string) *video {
+	e.Attribute("playsinline", value)
 	return e
 }
 
-// ForIf conditionally sets the "for" attribute
+// PlaysinlineIf conditionally sets the "playsinline" attribute
 // Only sets the attribute if the condition is true
-func (e *label) ForIf(condition bool, value string) *label {
+func (e *video) PlaysinlineIf(condition bool, value string) *video {
 	if condition {
-		e.Attribute("for", value)
+		e.Attribute("playsinline", value)
 	}
 	return e
 }
 
-// Legend represents the <legend> HTML element
-type legend struct {
+// Wbr represents the <wbr> HTML element
+type wbr struct {
 	// Embeds the base Tag to inherit core HTML element functionality
 	*Tag
 }
 
-// Legend creates a new legend element
-// Allows optional child nodes to be passed during creation
-func Legend(children ...Node) *legend {
-	return &legend{NewTag("legend", false, children)}
+// Wbr creates a new wbr element
+// Takes no children since a void element can't have any
+func Wbr() *wbr {
+	return &wbr{NewTag("wbr", voidElements["wbr"], nil)}
 }
 
-// Li represents the <li> HTML element
-type li struct {
+// Circle represents the <circle> HTML element
+type circle struct {
 	// Embeds the base Tag to inherit core HTML element functionality
 	*Tag
-}
 
-// Li creates a new li element
-// Allows optional child nodes to be passed during creation
-func Li(children ...Node) *li {
-	return &li{NewTag("li", false, children)}
+	// Embeds the shared SVG presentation attribute setters
+	svgPresentation[*circle]
 }
 
-// Link represents the <link> HTML element
-type link struct {
-	// Embeds the base Tag to inherit core HTML element functionality
-	*Tag
+// Circle creates a new circle element
+// Allows optional child nodes to be passed during creation
+func Circle(children ...Node) *circle {
+	e := &circle{Tag: NewTag("circle", false, children)}
+	e.svgPresentation = svgPresentation[*circle]{tag: e.Tag, self: e}
+	return e
 }
 
-// Rel sets the "rel" attribute
+// Cx sets the "cx" attribute
 // Returns the element itself to enable method chaining
-func (e *link) Rel(value string) *link {
-	e.Attribute("rel", value)
+func (e *circle) Cx(value string) *circle {
+	e.Attribute("cx", value)
 	return e
 }
 
-// RelIf conditionally sets the "rel" attribute
+// CxIf conditionally sets the "cx" attribute
 // Only sets the attribute if the condition is true
-func (e *link) RelIf(condition bool, value string) *link {
+func (e *circle) CxIf(condition bool, value string) *circle {
 	if condition {
-		e.Attribute("rel", value)
+		e.Attribute("cx", value)
 	}
 	return e
 }
 
-// Href sets the "href" attribute
+// Cy sets the "cy" attribute
 // Returns the element itself to enable method chaining
-func (e *link) Href(value string) *link {
-	e.Attribute("href", value)
+func (e *circle) Cy(value string) *circle {
+	e.Attribute("cy", value)
 	return e
 }
 
-// HrefIf conditionally sets the "href" attribute
+// CyIf conditionally sets the "cy" attribute
 // Only sets the attribute if the condition is true
-func (e *link) HrefIf(condition bool, value string) *link {
+func (e *circle) CyIf(condition bool, value string) *circle {
 	if condition {
-		e.Attribute("href", value)
+		e.Attribute("cy", value)
 	}
 	return e
 }
 
-// Link creates a new link element
-// Allows optional child nodes to be passed during creation
-func Link(children ...Node) *link {
-	return &link{NewTag("link", true, children)}
-}
-
-// Integrity sets the "integrity" attribute
+// R sets the "r" attribute
 // Returns the element itself to enable method chaining
-func (e *link) Integrity(value string) *link {
-	e.Attribute("integrity", value)
+func (e *circle) R(value string) *circle {
+	e.Attribute("r", value)
 	return e
 }
 
-// IntegrityIf conditionally sets the "integrity" attribute
+// RIf conditionally sets the "r" attribute
 // Only sets the attribute if the condition is true
-func (e *link) IntegrityIf(condition bool, value string) *link {
+func (e *circle) RIf(condition bool, value string) *circle {
 	if condition {
-		e.Attribute("integrity", value)
+		e.Attribute("r", value)
 	}
 	return e
 }
 
-// Main represents the <main> HTML element
-type main struct {
+// Ellipse represents the <ellipse> HTML element
+type ellipse struct {
 	// Embeds the base Tag to inherit core HTML element functionality
 	*Tag
+
+	// Embeds the shared SVG presentation attribute setters
+	svgPresentation[*ellipse]
 }
 
-// Main creates a new main element
+// Ellipse creates a new ellipse element
 // Allows optional child nodes to be passed during creation
-func Main(children ...Node) *main {
-	return &main{NewTag("main", false, children)}
+func Ellipse(children ...Node) *ellipse {
+	e := &ellipse{Tag: NewTag("ellipse", false, children)}
+	e.svgPresentation = svgPresentation[*ellipse]{tag: e.Tag, self: e}
+	return e
 }
 
-// mapEl_ represents the <map> HTML element
-type mapEl_ struct {
+// G represents the <g> HTML element
+type g struct {
 	// Embeds the base Tag to inherit core HTML element functionality
 	*Tag
+
+	// Embeds the shared SVG presentation attribute setters
+	svgPresentation[*g]
 }
 
-// Map creates a new map element
+// G creates a new g element
 // Allows optional child nodes to be passed during creation
-func MapEl(children ...Node) *mapEl_ {
-	return &mapEl_{NewTag("map", false, children)}
+func G(children ...Node) *g {
+	e := &g{Tag: NewTag("g", false, children)}
+	e.svgPresentation = svgPresentation[*g]{tag: e.Tag, self: e}
+	return e
 }
 
-// Mark represents the <mark> HTML element
-type mark struct {
-	// Embeds the base Tag to inherit core HTML element functionality
-	*Tag
+// ID sets the "id" attribute, keeping the g type for further chaining
+// Returns the element itself to enable method chaining
+func (e *g) ID(value string) *g {
+	e.Tag.ID(value)
+	return e
 }
 
-// Mark creates a new mark element
-// Allows optional child nodes to be passed during creation
-func Mark(children ...Node) *mark {
-	return &mark{NewTag("mark", false, children)}
+// IDIf conditionally sets the "id" attribute
+// Only sets the attribute if the condition is true
+func (e *g) IDIf(condition bool, value string) *g {
+	e.Tag.IDIf(condition, value)
+	return e
 }
 
-// Meta represents the <meta> HTML element
-type meta struct {
+// Class sets the "class" attribute, keeping the g type for further chaining
+// Returns the element itself to enable method chaining
+func (e *g) Class(values ...string) *g {
+	e.Tag.Class(values...)
+	return e
+}
+
+// ClassIf conditionally sets the "class" attribute
+// Only sets the attribute if the condition is true
+func (e *g) ClassIf(condition bool, value string) *g {
+	e.Tag.ClassIf(condition, value)
+	return e
+}
+
+// Line represents the <line> HTML element
+type line struct {
 	// Embeds the base Tag to inherit core HTML element functionality
 	*Tag
+
+	// Embeds the shared SVG presentation attribute setters
+	svgPresentation[*line]
 }
 
-// Meta creates a new meta element
+// Line creates a new line element
 // Allows optional child nodes to be passed during creation
-func Meta(children ...Node) *meta {
-	return &meta{NewTag("meta", true, children)}
+func Line(children ...Node) *line {
+	e := &line{Tag: NewTag("line", false, children)}
+	e.svgPresentation = svgPresentation[*line]{tag: e.Tag, self: e}
+	return e
 }
 
-// Name sets the "name" attribute
+// X1 sets the "x1" attribute
 // Returns the element itself to enable method chaining
-func (e *meta) Name(value string) *meta {
-	e.Attribute("name", value)
+func (e *line) X1(value string) *line {
+	e.Attribute("x1", value)
 	return e
 }
 
-// NameIf conditionally sets the "name" attribute
+// X1If conditionally sets the "x1" attribute
 // Only sets the attribute if the condition is true
-func (e *meta) NameIf(condition bool, value string) *meta {
+func (e *line) X1If(condition bool, value string) *line {
 	if condition {
-		e.Attribute("name", value)
+		e.Attribute("x1", value)
 	}
 	return e
 }
 
-// Content sets the "content" attribute
+// Y1 sets the "y1" attribute
 // Returns the element itself to enable method chaining
-func (e *meta) Content(value string) *meta {
-	e.Attribute("content", value)
+func (e *line) Y1(value string) *line {
+	e.Attribute("y1", value)
 	return e
 }
 
-// ContentIf conditionally sets the "content" attribute
+// Y1If conditionally sets the "y1" attribute
 // Only sets the attribute if the condition is true
-func (e *meta) ContentIf(condition bool, value string) *meta {
+func (e *line) Y1If(condition bool, value string) *line {
 	if condition {
-		e.Attribute("content", value)
+		e.Attribute("y1", value)
 	}
 	return e
 }
 
-// Charset sets the "charset" attribute
+// X2 sets the "x2" attribute
 // Returns the element itself to enable method chaining
-func (e *meta) Charset(value string) *meta {
-	e.Attribute("charset", value)
+func (e *line) X2(value string) *line {
+	e.Attribute("x2", value)
 	return e
 }
 
-// CharsetIf conditionally sets the "charset" attribute
+// X2If conditionally sets the "x2" attribute
 // Only sets the attribute if the condition is true
-func (e *meta) CharsetIf(condition bool, value string) *meta {
+func (e *line) X2If(condition bool, value string) *line {
 	if condition {
-		e.Attribute("charset", value)
+		e.Attribute("x2", value)
 	}
 	return e
 }
 
-// HttpEquiv sets the "http-equiv" attribute
+// Y2 sets the "y2" attribute
 // Returns the element itself to enable method chaining
-func (e *meta) HttpEquiv(value string) *meta {
-	e.Attribute("http-equiv", value)
+func (e *line) Y2(value string) *line {
+	e.Attribute("y2", value)
 	return e
 }
 
-// HttpEquivIf conditionally sets the "http-equiv" attribute
+// Y2If conditionally sets the "y2" attribute
 // Only sets the attribute if the condition is true
-func (e *meta) HttpEquivIf(condition bool, value string) *meta {
+func (e *line) Y2If(condition bool, value string) *line {
 	if condition {
-		e.Attribute("http-equiv", value)
+		e.Attribute("y2", value)
 	}
 	return e
 }
 
-// Meter represents the <meter> HTML element
-type meter struct {
+// Path represents the <path> HTML element
+type path struct {
 	// Embeds the base Tag to inherit core HTML element functionality
 	*Tag
+
+	// Embeds the shared SVG presentation attribute setters
+	svgPresentation[*path]
 }
 
-// Meter creates a new meter element
+// Path creates a new path element
 // Allows optional child nodes to be passed during creation
-func Meter(children ...Node) *meter {
-	return &meter{NewTag("meter", false, children)}
+func Path(children ...Node) *path {
+	e := &path{Tag: NewTag("path", false, children)}
+	e.svgPresentation = svgPresentation[*path]{tag: e.Tag, self: e}
+	return e
 }
 
-// Low sets the "low" attribute
+// ID sets the "id" attribute, keeping the path type for further chaining
 // Returns the element itself to enable method chaining
-func (e *meter) Low(value string) *meter {
-	e.Attribute("low", value)
+func (e *path) ID(value string) *path {
+	e.Tag.ID(value)
 	return e
 }
 
-// LowIf conditionally sets the "low" attribute
+// IDIf conditionally sets the "id" attribute
 // Only sets the attribute if the condition is true
-func (e *meter) LowIf(condition bool, value string) *meter {
-	if condition {
-		e.Attribute("low", value)
-	}
+func (e *path) IDIf(condition bool, value string) *path {
+	e.Tag.IDIf(condition, value)
 	return e
 }
 
-// High sets the "high" attribute
+// Class sets the "class" attribute, keeping the path type for further chaining
 // Returns the element itself to enable method chaining
-func (e *meter) High(value string) *meter {
-	e.Attribute("high", value)
+func (e *path) Class(values ...string) *path {
+	e.Tag.Class(values...)
 	return e
 }
 
-// HighIf conditionally sets the "high" attribute
+// ClassIf conditionally sets the "class" attribute
 // Only sets the attribute if the condition is true
-func (e *meter) HighIf(condition bool, value string) *meter {
-	if condition {
-		e.Attribute("high", value)
-	}
+func (e *path) ClassIf(condition bool, value string) *path {
+	e.Tag.ClassIf(condition, value)
 	return e
 }
 
-// Optimum sets the "optimum" attribute
+// D sets the "d" attribute
 // Returns the element itself to enable method chaining
-func (e *meter) Optimum(value string) *meter {
-	e.Attribute("optimum", value)
+func (e *path) D(value string) *path {
+	e.Attribute("d", value)
 	return e
 }
 
-// OptimumIf conditionally sets the "optimum" attribute
+// DIf conditionally sets the "d" attribute
 // Only sets the attribute if the condition is true
-func (e *meter) OptimumIf(condition bool, value string) *meter {
+func (e *path) DIf(condition bool, value string) *path {
 	if condition {
-		e.Attribute("optimum", value)
+		e.Attribute("d", value)
 	}
 	return e
 }
 
-// Nav represents the <nav> HTML element
-type nav struct {
+// Polygon represents the <polygon> HTML element
+type polygon struct {
 	// Embeds the base Tag to inherit core HTML element functionality
 	*Tag
-}
-
-// Nav creates a new nav element
-// Allows optional child nodes to be passed during creation
-func Nav(children ...Node) *nav {
-	return &nav{NewTag("nav", false, children)}
-}
 
-// Noscript represents the <noscript> HTML element
-type noscript struct {
-	// Embeds the base Tag to inherit core HTML element functionality
-	*Tag
+	// Embeds the shared SVG presentation attribute setters
+	svgPresentation[*polygon]
 }
 
-// Noscript creates a new noscript element
+// Polygon creates a new polygon element
 // Allows optional child nodes to be passed during creation
-func Noscript(children ...Node) *noscript {
-	return &noscript{NewTag("noscript", false, children)}
+func Polygon(children ...Node) *polygon {
+	e := &polygon{Tag: NewTag("polygon", false, children)}
+	e.svgPresentation = svgPresentation[*polygon]{tag: e.Tag, self: e}
+	return e
 }
 
-// Object represents the <object> HTML element
-type object struct {
-	// Embeds the base Tag to inherit core HTML element functionality
-	*Tag
+// ID sets the "id" attribute, keeping the polygon type for further chaining
+// Returns the element itself to enable method chaining
+func (e *polygon) ID(value string) *polygon {
+	e.Tag.ID(value)
+	return e
 }
 
-// Object creates a new object element
-// Allows optional child nodes to be passed during creation
-func Object(children ...Node) *object {
-	return &object{NewTag("object", false, children)}
+// IDIf conditionally sets the "id" attribute
+// Only sets the attribute if the condition is true
+func (e *polygon) IDIf(condition bool, value string) *polygon {
+	e.Tag.IDIf(condition, value)
+	return e
 }
 
-// Ol represents the <ol> HTML element
-type ol struct {
-	// Embeds the base Tag to inherit core HTML element functionality
-	*Tag
+// Class sets the "class" attribute, keeping the polygon type for further chaining
+// Returns the element itself to enable method chaining
+func (e *polygon) Class(values ...string) *polygon {
+	e.Tag.Class(values...)
+	return e
 }
 
-// Ol creates a new ol element
-// Allows optional child nodes to be passed during creation
-func Ol(children ...Node) *ol {
-	return &ol{NewTag("ol", false, children)}
+// ClassIf conditionally sets the "class" attribute
+// Only sets the attribute if the condition is true
+func (e *polygon) ClassIf(condition bool, value string) *polygon {
+	e.Tag.ClassIf(condition, value)
+	return e
 }
 
-// Start sets the "start" attribute
+// Points sets the "points" attribute
 // Returns the element itself to enable method chaining
-func (e *ol) Start(value string) *ol {
-	e.Attribute("start", value)
+func (e *polygon) Points(value string) *polygon {
+	e.Attribute("points", value)
 	return e
 }
 
-// StartIf conditionally sets the "start" attribute
+// PointsIf conditionally sets the "points" attribute
 // Only sets the attribute if the condition is true
-func (e *ol) StartIf(condition bool, value string) *ol {
+func (e *polygon) PointsIf(condition bool, value string) *polygon {
 	if condition {
-		e.Attribute("start", value)
+		e.Attribute("points", value)
 	}
 	return e
 }
 
-// Reversed sets the "reversed" attribute
+// Polyline represents the <polyline> HTML element
+type polyline struct {
+	// Embeds the base Tag to inherit core HTML element functionality
+	*Tag
+
+	// Embeds the shared SVG presentation attribute setters
+	svgPresentation[*polyline]
+}
+
+// Polyline creates a new polyline element
+// Allows optional child nodes to be passed during creation
+func Polyline(children ...Node) *polyline {
+	e := &polyline{Tag: NewTag("polyline", false, children)}
+	e.svgPresentation = svgPresentation[*polyline]{tag: e.Tag, self: e}
+	return e
+}
+
+// Points sets the "points" attribute
 // Returns the element itself to enable method chaining
-func (e *ol) Reversed(value string) *ol {
-	e.Attribute("reversed", value)
+func (e *polyline) Points(value string) *polyline {
+	e.Attribute("points", value)
 	return e
 }
 
-// ReversedIf conditionally sets the "reversed" attribute
+// PointsIf conditionally sets the "points" attribute
 // Only sets the attribute if the condition is true
-func (e *ol) ReversedIf(condition bool, value string) *ol {
+func (e *polyline) PointsIf(condition bool, value string) *polyline {
 	if condition {
-		e.Attribute("reversed", value)
+		e.Attribute("points", value)
 	}
 	return e
 }
 
-// Optgroup represents the <optgroup> HTML element
-type optgroup struct {
-	// Embeds the base Tag to inherit core HTML element functionality
-	*Tag
+// ID sets the "id" attribute, keeping the polyline type for further chaining
+// Returns the element itself to enable method chaining
+func (e *polyline) ID(value string) *polyline {
+	e.Tag.ID(value)
+	return e
 }
 
-// Optgroup creates a new optgroup element
-// Allows optional child nodes to be passed during creation
-func Optgroup(children ...Node) *optgroup {
-	return &optgroup{NewTag("optgroup", false, children)}
+// IDIf conditionally sets the "id" attribute
+// Only sets the attribute if the condition is true
+func (e *polyline) IDIf(condition bool, value string) *polyline {
+	e.Tag.IDIf(condition, value)
+	return e
 }
 
-// Label sets the "label" attribute
+// Class sets the "class" attribute, keeping the polyline type for further chaining
 // Returns the element itself to enable method chaining
-func (e *optgroup) Label(value string) *optgroup {
-	e.Attribute("label", value)
+func (e *polyline) Class(values ...string) *polyline {
+	e.Tag.Class(values...)
 	return e
 }
 
-// LabelIf conditionally sets the "label" attribute
+// ClassIf conditionally sets the "class" attribute
 // Only sets the attribute if the condition is true
-func (e *optgroup) LabelIf(condition bool, value string) *optgroup {
-	if condition {
-		e.Attribute("label", value)
-	}
+func (e *polyline) ClassIf(condition bool, value string) *polyline {
+	e.Tag.ClassIf(condition, value)
 	return e
 }
 
-// Option represents the <option> HTML element
-type option struct {
+// Rect represents the <rect> HTML element
+type rect struct {
 	// Embeds the base Tag to inherit core HTML element functionality
 	*Tag
+
+	// Embeds the shared SVG presentation attribute setters
+	svgPresentation[*rect]
 }
 
-// Option creates a new option element
+// Rect creates a new rect element
 // Allows optional child nodes to be passed during creation
-func Option(children ...Node) *option {
-	return &option{NewTag("option", false, children)}
+func Rect(children ...Node) *rect {
+	e := &rect{Tag: NewTag("rect", false, children)}
+	e.svgPresentation = svgPresentation[*rect]{tag: e.Tag, self: e}
+	return e
 }
 
-// Selected sets the "selected" attribute
+// Width sets the "width" attribute
 // Returns the element itself to enable method chaining
-func (e *option) Selected(value string) *option {
-	e.Attribute("selected", value)
+func (e *rect) Width(value string) *rect {
+	e.Attribute("width", value)
 	return e
 }
 
-// SelectedIf conditionally sets the "selected" attribute
+// WidthIf conditionally sets the "width" attribute
 // Only sets the attribute if the condition is true
-func (e *option) SelectedIf(condition bool, value string) *option {
+func (e *rect) WidthIf(condition bool, value string) *rect {
 	if condition {
-		e.Attribute("selected", value)
+		e.Attribute("width", value)
 	}
 	return e
 }
 
-// Output represents the <output> HTML element
-type output struct {
-	// Embeds the base Tag to inherit core HTML element functionality
-	*Tag
+// WidthInt sets the "width" attribute to an integer value
+// Returns the element itself to enable method chaining
+func (e *rect) WidthInt(value int) *rect {
+	e.AttributeInt("width", value)
+	return e
 }
 
-// Output creates a new output element
-// Allows optional child nodes to be passed during creation
-func Output(children ...Node) *output {
-	return &output{NewTag("output", false, children)}
+// Height sets the "height" attribute
+// Returns the element itself to enable method chaining
+func (e *rect) Height(value string) *rect {
+	e.Attribute("height", value)
+	return e
 }
 
-// P represents the <p> HTML element
-type p struct {
-	// Embeds the base Tag to inherit core HTML element functionality
-	*Tag
+// HeightIf conditionally sets the "height" attribute
+// Only sets the attribute if the condition is true
+func (e *rect) HeightIf(condition bool, value string) *rect {
+	if condition {
+		e.Attribute("height", value)
+	}
+	return e
 }
 
-// P creates a new p element
-// Allows optional child nodes to be passed during creation
-func P(children ...Node) *p {
-	return &p{NewTag("p", false, children)}
+// HeightInt sets the "height" attribute to an integer value
+// Returns the element itself to enable method chaining
+func (e *rect) HeightInt(value int) *rect {
+	e.AttributeInt("height", value)
+	return e
 }
 
-// Param represents the <param> HTML element
-type param struct {
-	// Embeds the base Tag to inherit core HTML element functionality
-	*Tag
+// ID sets the "id" attribute, keeping the rect type for further chaining
+// Returns the element itself to enable method chaining
+func (e *rect) ID(value string) *rect {
+	e.Tag.ID(value)
+	return e
 }
 
-// Param creates a new param element
-// Allows optional child nodes to be passed during creation
-func Param(children ...Node) *param {
-	return &param{NewTag("param", true, children)}
+// IDIf conditionally sets the "id" attribute
+// Only sets the attribute if the condition is true
+func (e *rect) IDIf(condition bool, value string) *rect {
+	e.Tag.IDIf(condition, value)
+	return e
 }
 
-// Picture represents the <picture> HTML element
-type picture struct {
-	// Embeds the base Tag to inherit core HTML element functionality
-	*Tag
+// Class sets the "class" attribute, keeping the rect type for further chaining
+// Returns the element itself to enable method chaining
+func (e *rect) Class(values ...string) *rect {
+	e.Tag.Class(values...)
+	return e
 }
 
-// Picture creates a new picture element
-// Allows optional child nodes to be passed during creation
-func Picture(children ...Node) *picture {
-	return &picture{NewTag("picture", false, children)}
+// ClassIf conditionally sets the "class" attribute
+// Only sets the attribute if the condition is true
+func (e *rect) ClassIf(condition bool, value string) *rect {
+	e.Tag.ClassIf(condition, value)
+	return e
 }
 
-// Pre represents the <pre> HTML element
-type pre struct {
-	// Embeds the base Tag to inherit core HTML element functionality
-	*Tag
+// X sets the "x" attribute
+// Returns the element itself to enable method chaining
+func (e *rect) X(value string) *rect {
+	e.Attribute("x", value)
+	return e
 }
 
-// Pre creates a new pre element
-// Allows optional child nodes to be passed during creation
-func Pre(children ...Node) *pre {
-	return &pre{NewTag("pre", false, children)}
+// XIf conditionally sets the "x" attribute
+// Only sets the attribute if the condition is true
+func (e *rect) XIf(condition bool, value string) *rect {
+	if condition {
+		e.Attribute("x", value)
+	}
+	return e
 }
 
-// Progress represents the <progress> HTML element
-type progress struct {
-	// Embeds the base Tag to inherit core HTML element functionality
-	*Tag
+// Y sets the "y" attribute
+// Returns the element itself to enable method chaining
+func (e *rect) Y(value string) *rect {
+	e.Attribute("y", value)
+	return e
 }
 
-// Progress creates a new progress element
-// Allows optional child nodes to be passed during creation
-func Progress(children ...Node) *progress {
-	return &progress{NewTag("progress", false, children)}
+// YIf conditionally sets the "y" attribute
+// Only sets the attribute if the condition is true
+func (e *rect) YIf(condition bool, value string) *rect {
+	if condition {
+		e.Attribute("y", value)
+	}
+	return e
 }
 
-// Q represents the <q> HTML element
-type q struct {
-	// Embeds the base Tag to inherit core HTML element functionality
-	*Tag
+// Rx sets the "rx" attribute
+// Returns the element itself to enable method chaining
+func (e *rect) Rx(value string) *rect {
+	e.Attribute("rx", value)
+	return e
 }
 
-// Q creates a new q element
-// Allows optional child nodes to be passed during creation
-func Q(children ...Node) *q {
-	return &q{NewTag("q", false, children)}
+// RxIf conditionally sets the "rx" attribute
+// Only sets the attribute if the condition is true
+func (e *rect) RxIf(condition bool, value string) *rect {
+	if condition {
+		e.Attribute("rx", value)
+	}
+	return e
 }
 
-// Rp represents the <rp> HTML element
-type rp struct {
-	// Embeds the base Tag to inherit core HTML element functionality
-	*Tag
+// Ry sets the "ry" attribute
+// Returns the element itself to enable method chaining
+func (e *rect) Ry(value string) *rect {
+	e.Attribute("ry", value)
+	return e
 }
 
-// Rp creates a new rp element
-// Allows optional child nodes to be passed during creation
-func Rp(children ...Node) *rp {
-	return &rp{NewTag("rp", false, children)}
+// RyIf conditionally sets the "ry" attribute
+// Only sets the attribute if the condition is true
+func (e *rect) RyIf(condition bool, value string) *rect {
+	if condition {
+		e.Attribute("ry", value)
+	}
+	return e
 }
 
-// Rt represents the <rt> HTML element
-type rt struct {
+// Use represents the <use> HTML element
+type use struct {
 	// Embeds the base Tag to inherit core HTML element functionality
 	*Tag
+
+	// Embeds the shared SVG presentation attribute setters
+	svgPresentation[*use]
 }
 
-// Rt creates a new rt element
+// Use creates a new use element
 // Allows optional child nodes to be passed during creation
-func Rt(children ...Node) *rt {
-	return &rt{NewTag("rt", false, children)}
+func Use(children ...Node) *use {
+	e := &use{Tag: NewTag("use", false, children)}
+	e.svgPresentation = svgPresentation[*use]{tag: e.Tag, self: e}
+	return e
 }
 
-// Ruby represents the <ruby> HTML element
-type ruby struct {
-	// Embeds the base Tag to inherit core HTML element functionality
-	*Tag
+// Href sets the "href" attribute
+// Returns the element itself to enable method chaining
+func (e *use) Href(value string) *use {
+	e.Attribute("href", value)
+	return e
 }
 
-// Ruby creates a new ruby element
-// Allows optional child nodes to be passed during creation
-func Ruby(children ...Node) *ruby {
-	return &ruby{NewTag("ruby", false, children)}
+// HrefIf conditionally sets the "href" attribute
+// Only sets the attribute if the condition is true
+func (e *use) HrefIf(condition bool, value string) *use {
+	if condition {
+		e.Attribute("href", value)
+	}
+	return e
 }
 
-// S represents the <s> HTML element
-type s struct {
-	// Embeds the base Tag to inherit core HTML element functionality
-	*Tag
+// XlinkHref sets the "xlink:href" attribute
+// Returns the element itself to enable method chaining
+func (e *use) XlinkHref(value string) *use {
+	e.Attribute("xlink:href", value)
+	return e
 }
 
-// S creates a new s element
-// Allows optional child nodes to be passed during creation
-func S(children ...Node) *s {
-	return &s{NewTag("s", false, children)}
+// XlinkHrefIf conditionally sets the "xlink:href" attribute
+// Only sets the attribute if the condition is true
+func (e *use) XlinkHrefIf(condition bool, value string) *use {
+	if condition {
+		e.Attribute("xlink:href", value)
+	}
+	return e
 }
 
-// Samp represents the <samp> HTML element
-type samp struct {
+// Image represents the <image> SVG element
+type image struct {
 	// Embeds the base Tag to inherit core HTML element functionality
 	*Tag
+
+	// Embeds the shared SVG presentation attribute setters
+	svgPresentation[*image]
 }
 
-// Samp creates a new samp element
+// Image creates a new SVG image element, embedding a raster or SVG image into
+// a drawing, use Img for HTML images
 // Allows optional child nodes to be passed during creation
-func Samp(children ...Node) *samp {
-	return &samp{NewTag("samp", false, children)}
+func Image(children ...Node) *image {
+	e := &image{Tag: NewTag("image", false, children)}
+	e.svgPresentation = svgPresentation[*image]{tag: e.Tag, self: e}
+	return e
 }
 
-// Script represents the <script> HTML element
-type script struct {
-	// Embeds the base Tag to inherit core HTML element functionality
-	*Tag
+// Href sets the "href" attribute
+// Returns the element itself to enable method chaining
+func (e *image) Href(value string) *image {
+	e.Attribute("href", value)
+	return e
 }
 
-// Script creates a new script element
-// Allows optional child nodes to be passed during creation
-func Script(children ...Node) *script {
-	return &script{NewTag("script", false, children)}
+// HrefIf conditionally sets the "href" attribute
+// Only sets the attribute if the condition is true
+func (e *image) HrefIf(condition bool, value string) *image {
+	if condition {
+		e.Attribute("href", value)
+	}
+	return e
 }
 
-// Async sets the "async" attribute
+// XlinkHref sets the "xlink:href" attribute
 // Returns the element itself to enable method chaining
-func (e *script) Async(value string) *script {
-	e.Attribute("async", value)
+func (e *image) XlinkHref(value string) *image {
+	e.Attribute("xlink:href", value)
 	return e
 }
 
-// AsyncIf conditionally sets the "async" attribute
+// XlinkHrefIf conditionally sets the "xlink:href" attribute
 // Only sets the attribute if the condition is true
-func (e *script) AsyncIf(condition bool, value string) *script {
+func (e *image) XlinkHrefIf(condition bool, value string) *image {
 	if condition {
-		e.Attribute("async", value)
+		e.Attribute("xlink:href", value)
 	}
 	return e
 }
 
-// Defer sets the "defer" attribute
+// X sets the "x" attribute
 // Returns the element itself to enable method chaining
-func (e *script) Defer(value string) *script {
-	e.Attribute("defer", value)
+func (e *image) X(value string) *image {
+	e.Attribute("x", value)
 	return e
 }
 
-// DeferIf conditionally sets the "defer" attribute
+// XIf conditionally sets the "x" attribute
 // Only sets the attribute if the condition is true
-func (e *script) DeferIf(condition bool, value string) *script {
+func (e *image) XIf(condition bool, value string) *image {
 	if condition {
-		e.Attribute("defer", value)
+		e.Attribute("x", value)
 	}
 	return e
 }
 
-// Nomodule sets the "nomodule" attribute
+// Y sets the "y" attribute
 // Returns the element itself to enable method chaining
-func (e *script) Nomodule(value string) *script {
-	e.Attribute("nomodule", value)
+func (e *image) Y(value string) *image {
+	e.Attribute("y", value)
 	return e
 }
 
-// NomoduleIf conditionally sets the "nomodule" attribute
+// YIf conditionally sets the "y" attribute
 // Only sets the attribute if the condition is true
-func (e *script) NomoduleIf(condition bool, value string) *script {
+func (e *image) YIf(condition bool, value string) *image {
 	if condition {
-		e.Attribute("nomodule", value)
+		e.Attribute("y", value)
 	}
 	return e
 }
 
-// Type sets the "type" attribute
+// Width sets the "width" attribute
 // Returns the element itself to enable method chaining
-func (e *script) Type(value string) *script {
-	e.Attribute("type", value)
+func (e *image) Width(value string) *image {
+	e.Attribute("width", value)
 	return e
 }
 
-// TypeIf conditionally sets the "type" attribute
+// WidthIf conditionally sets the "width" attribute
 // Only sets the attribute if the condition is true
-func (e *script) TypeIf(condition bool, value string) *script {
+func (e *image) WidthIf(condition bool, value string) *image {
 	if condition {
-		e.Attribute("type", value)
+		e.Attribute("width", value)
 	}
 	return e
 }
 
-// Src sets the "src" attribute
+// WidthInt sets the "width" attribute to an integer value
 // Returns the element itself to enable method chaining
-func (e *script) Src(value string) *script {
-	e.Attribute("src", value)
+func (e *image) WidthInt(value int) *image {
+	e.AttributeInt("width", value)
 	return e
 }
 
-// SrcIf conditionally sets the "src" attribute
+// Height sets the "height" attribute
+// Returns the element itself to enable method chaining
+func (e *image) Height(value string) *image {
+	e.Attribute("height", value)
+	return e
+}
+
+// HeightIf conditionally sets the "height" attribute
 // Only sets the attribute if the condition is true
-func (e *script) SrcIf(condition bool, value string) *script {
+func (e *image) HeightIf(condition bool, value string) *image {
 	if condition {
-		e.Attribute("Src", value)
+		e.Attribute("height", value)
 	}
 	return e
 }
 
-// Section represents the <section> HTML element
-type section struct {
-	// Embeds the base Tag to inherit core HTML element functionality
-	*Tag
-}
-
-// Section creates a new section element
-// Allows optional child nodes to be passed during creation
-func Section(children ...Node) *section {
-	return &section{NewTag("section", false, children)}
+// HeightInt sets the "height" attribute to an integer value
+// Returns the element itself to enable method chaining
+func (e *image) HeightInt(value int) *image {
+	e.AttributeInt("height", value)
+	return e
 }
 
-// Select represents the <select> HTML element
-type select_ struct {
+// Text_ represents the <text> HTML element
+type text_ struct {
 	// Embeds the base Tag to inherit core HTML element functionality
 	*Tag
+
+	// Embeds the shared SVG presentation attribute setters
+	svgPresentation[*text_]
 }
 
-// Select creates a new select element
+// Text_ creates a new text element
 // Allows optional child nodes to be passed during creation
-func Select(children ...Node) *select_ {
-	return &select_{NewTag("select", false, children)}
+func Text_(children ...Node) *text_ {
+	e := &text_{Tag: NewTag("text", false, children)}
+	e.svgPresentation = svgPresentation[*text_]{tag: e.Tag, self: e}
+	return e
 }
 
-// Multiple sets the "multiple" attribute
+// X sets the "x" attribute
 // Returns the element itself to enable method chaining
-func (e *select_) Multiple(value string) *select_ {
-	e.Attribute("multiple", value)
+func (e *text_) X(value string) *text_ {
+	e.Attribute("x", value)
 	return e
 }
 
-// MultipleIf conditionally sets the "multiple" attribute
+// XIf conditionally sets the "x" attribute
 // Only sets the attribute if the condition is true
-func (e *select_) MultipleIf(condition bool, value string) *select_ {
+func (e *text_) XIf(condition bool, value string) *text_ {
 	if condition {
-		e.Attribute("multiple", value)
+		e.Attribute("x", value)
 	}
 	return e
 }
 
-// Size sets the "size" attribute
+// Y sets the "y" attribute
 // Returns the element itself to enable method chaining
-func (e *select_) Size(value string) *select_ {
-	e.Attribute("size", value)
+func (e *text_) Y(value string) *text_ {
+	e.Attribute("y", value)
 	return e
 }
 
-// SizeIf conditionally sets the "size" attribute
+// YIf conditionally sets the "y" attribute
 // Only sets the attribute if the condition is true
-func (e *select_) SizeIf(condition bool, value string) *select_ {
+func (e *text_) YIf(condition bool, value string) *text_ {
 	if condition {
-		e.Attribute("size", value)
+		e.Attribute("y", value)
 	}
 	return e
 }
 
-// Small represents the <small> HTML element
-type small struct {
-	// Embeds the base Tag to inherit core HTML element functionality
-	*Tag
-}
-
-// Small creates a new small element
-// Allows optional child nodes to be passed during creation
-func Small(children ...Node) *small {
-	return &small{NewTag("small", false, children)}
-}
-
-// Source represents the <source> HTML element
-type source struct {
-	// Embeds the base Tag to inherit core HTML element functionality
-	*Tag
-}
-
-// Source creates a new source element
-// Allows optional child nodes to be passed during creation
-func Source(children ...Node) *source {
-	return &source{NewTag("source", true, children)}
-}
-
-// Span represents the <span> HTML element
-type span struct {
-	// Embeds the base Tag to inherit core HTML element functionality
-	*Tag
-}
-
-// Span creates a new span element
-// Allows optional child nodes to be passed during creation
-func Span(children ...Node) *span {
-	return &span{NewTag("span", false, children)}
-}
-
-// Strong represents the <strong> HTML element
-type strong struct {
-	// Embeds the base Tag to inherit core HTML element functionality
-	*Tag
+// Dx sets the "dx" attribute
+// Returns the element itself to enable method chaining
+func (e *text_) Dx(value string) *text_ {
+	e.Attribute("dx", value)
+	return e
 }
 
-// Strong creates a new strong element
-// Allows optional child nodes to be passed during creation
-func Strong(children ...Node) *strong {
-	return &strong{NewTag("strong", false, children)}
+// DxIf conditionally sets the "dx" attribute
+// Only sets the attribute if the condition is true
+func (e *text_) DxIf(condition bool, value string) *text_ {
+	if condition {
+		e.Attribute("dx", value)
+	}
+	return e
 }
 
-// Style represents the <style> HTML element
-type style struct {
-	// Embeds the base Tag to inherit core HTML element functionality
-	*Tag
+// Dy sets the "dy" attribute
+// Returns the element itself to enable method chaining
+func (e *text_) Dy(value string) *text_ {
+	e.Attribute("dy", value)
+	return e
 }
 
-// Style creates a new style element
-// Allows optional child nodes to be passed during creation
-func Style(children ...Node) *style {
-	return &style{NewTag("style", false, children)}
+// DyIf conditionally sets the "dy" attribute
+// Only sets the attribute if the condition is true
+func (e *text_) DyIf(condition bool, value string) *text_ {
+	if condition {
+		e.Attribute("dy", value)
+	}
+	return e
 }
 
-// Sub represents the <sub> HTML element
-type sub struct {
-	// Embeds the base Tag to inherit core HTML element functionality
-	*Tag
+// TextAnchor sets the "text-anchor" attribute
+// Returns the element itself to enable method chaining
+func (e *text_) TextAnchor(value string) *text_ {
+	e.Attribute("text-anchor", value)
+	return e
 }
 
-// Sub creates a new sub element
-// Allows optional child nodes to be passed during creation
-func Sub(children ...Node) *sub {
-	return &sub{NewTag("sub", false, children)}
+// TextAnchorIf conditionally sets the "text-anchor" attribute
+// Only sets the attribute if the condition is true
+func (e *text_) TextAnchorIf(condition bool, value string) *text_ {
+	if condition {
+		e.Attribute("text-anchor", value)
+	}
+	return e
 }
 
-// Summary represents the <summary> HTML element
-type summary struct {
+// Tspan represents the <tspan> HTML element
+type tspan struct {
 	// Embeds the base Tag to inherit core HTML element functionality
 	*Tag
-}
-
-// Summary creates a new summary element
-// Allows optional child nodes to be passed during creation
-func Summary(children ...Node) *summary {
-	return &summary{NewTag("summary", false, children)}
-}
 
-// Sup represents the <sup> HTML element
-type sup struct {
-	// Embeds the base Tag to inherit core HTML element functionality
-	*Tag
+	// Embeds the shared SVG presentation attribute setters
+	svgPresentation[*tspan]
 }
 
-// Sup creates a new sup element
+// Tspan creates a new tspan element
 // Allows optional child nodes to be passed during creation
-func Sup(children ...Node) *sup {
-	return &sup{NewTag("sup", false, children)}
+func Tspan(children ...Node) *tspan {
+	e := &tspan{Tag: NewTag("tspan", false, children)}
+	e.svgPresentation = svgPresentation[*tspan]{tag: e.Tag, self: e}
+	return e
 }
 
-// svg represents the <svg> HTML element
-type svg struct {
-	// Embeds the base Tag to inherit core HTML element functionality
-	*Tag
+// X sets the "x" attribute
+// Returns the element itself to enable method chaining
+func (e *tspan) X(value string) *tspan {
+	e.Attribute("x", value)
+	return e
 }
 
-// SVG creates a new svg element
-// Allows optional child nodes to be passed during creation
-func SVG(children ...Node) *svg {
-	return &svg{NewTag("svg", false, children)}
+// XIf conditionally sets the "x" attribute
+// Only sets the attribute if the condition is true
+func (e *tspan) XIf(condition bool, value string) *tspan {
+	if condition {
+		e.Attribute("x", value)
+	}
+	return e
 }
 
-// ViewBox sets the "viewBox" attribute
+// Y sets the "y" attribute
 // Returns the element itself to enable method chaining
-func (e *svg) ViewBox(value string) *svg {
-	e.Attribute("viewBox", value)
+func (e *tspan) Y(value string) *tspan {
+	e.Attribute("y", value)
 	return e
 }
 
-// ViewBoxIf conditionally sets the "viewBox" attribute
+// YIf conditionally sets the "y" attribute
 // Only sets the attribute if the condition is true
-func (e *svg) ViewBoxIf(condition bool, value string) *svg {
+func (e *tspan) YIf(condition bool, value string) *tspan {
 	if condition {
-		e.Attribute("viewBox", value)
+		e.Attribute("y", value)
 	}
 	return e
 }
 
-// PreserveAspectRatio sets the "preserveAspectRatio" attribute
+// Dx sets the "dx" attribute
 // Returns the element itself to enable method chaining
-func (e *svg) PreserveAspectRatio(value string) *svg {
-	e.Attribute("preserveAspectRatio", value)
+func (e *tspan) Dx(value string) *tspan {
+	e.Attribute("dx", value)
 	return e
 }
 
-// PreserveAspectRatioIf conditionally sets the "preserveAspectRatio" attribute
+// DxIf conditionally sets the "dx" attribute
 // Only sets the attribute if the condition is true
-func (e *svg) PreserveAspectRatioIf(condition bool, value string) *svg {
+func (e *tspan) DxIf(condition bool, value string) *tspan {
 	if condition {
-		e.Attribute("preserveAspectRatio", value)
+		e.Attribute("dx", value)
 	}
 	return e
 }
 
-// Xmlns sets the "xmlns" attribute
+// Dy sets the "dy" attribute
 // Returns the element itself to enable method chaining
-func (e *svg) Xmlns(value string) *svg {
-	e.Attribute("xmlns", value)
+func (e *tspan) Dy(value string) *tspan {
+	e.Attribute("dy", value)
 	return e
 }
 
-// XmlnsIf conditionally sets the "xmlns" attribute
+// DyIf conditionally sets the "dy" attribute
 // Only sets the attribute if the condition is true
-func (e *svg) XmlnsIf(condition bool, value string) *svg {
+func (e *tspan) DyIf(condition bool, value string) *tspan {
 	if condition {
-		e.Attribute("xmlns", value)
+		e.Attribute("dy", value)
 	}
 	return e
 }
 
-// Version sets the "version" attribute
+// TextAnchor sets the "text-anchor" attribute
 // Returns the element itself to enable method chaining
-func (e *svg) Version(value string) *svg {
-	e.Attribute("version", value)
+func (e *tspan) TextAnchor(value string) *tspan {
+	e.Attribute("text-anchor", value)
 	return e
 }
 
-// VersionIf conditionally sets the "version" attribute
+// TextAnchorIf conditionally sets the "text-anchor" attribute
 // Only sets the attribute if the condition is true
-func (e *svg) VersionIf(condition bool, value string) *svg {
+func (e *tspan) TextAnchorIf(condition bool, value string) *tspan {
 	if condition {
-		e.Attribute("version", value)
+		e.Attribute("text-anchor", value)
 	}
 	return e
 }
 
-// Table represents the <table> HTML element
-type table struct {
+// Defs represents the <defs> HTML element
+type defs struct {
 	// Embeds the base Tag to inherit core HTML element functionality
 	*Tag
 }
 
-// Table creates a new table element
+// Defs creates a new defs element
 // Allows optional child nodes to be passed during creation
-func Table(children ...Node) *table {
-	return &table{NewTag("table", false, children)}
+func Defs(children ...Node) *defs {
+	return &defs{NewTag("defs", false, children)}
 }
 
-// Border sets the "border" attribute
-// Returns the element itself to enable method chaining
-func (e *table) Border(value string) *table {
-	e.Attribute("border", value)
-	return e
+// LinearGradient represents the <linearGradient> HTML element
+type linearGradient struct {
+	// Embeds the base Tag to inherit core HTML element functionality
+	*Tag
 }
 
-// BorderIf conditionally sets the "border" attribute
-// Only sets the attribute if the condition is true
-func (e *table) BorderIf(condition bool, value string) *table {
-	if condition {
-		e.Attribute("border", value)
-	}
-	return e
+// LinearGradient creates a new linearGradient element
+// Allows optional child nodes to be passed during creation
+func LinearGradient(children ...Node) *linearGradient {
+	return &linearGradient{NewTag("linearGradient", false, children)}
 }
 
-// Cellpadding sets the "cellpadding" attribute
+// X1 sets the "x1" attribute
 // Returns the element itself to enable method chaining
-func (e *table) Cellpadding(value string) *table {
-	e.Attribute("cellpadding", value)
+func (e *linearGradient) X1(value string) *linearGradient {
+	e.Attribute("x1", value)
 	return e
 }
 
-// CellpaddingIf conditionally sets the "cellpadding" attribute
+// X1If conditionally sets the "x1" attribute
 // Only sets the attribute if the condition is true
-func (e *table) CellpaddingIf(condition bool, value string) *table {
+func (e *linearGradient) X1If(condition bool, value string) *linearGradient {
 	if condition {
-		e.Attribute("cellpadding", value)
+		e.Attribute("x1", value)
 	}
 	return e
 }
 
-// Cellspacing sets the "cellspacing" attribute
+// Y1 sets the "y1" attribute
 // Returns the element itself to enable method chaining
-func (e *table) Cellspacing(value string) *table {
-	e.Attribute("cellspacing", value)
+func (e *linearGradient) Y1(value string) *linearGradient {
+	e.Attribute("y1", value)
 	return e
 }
 
-// CellspacingIf conditionally sets the "cellspacing" attribute
+// Y1If conditionally sets the "y1" attribute
 // Only sets the attribute if the condition is true
-func (e *table) CellspacingIf(condition bool, value string) *table {
+func (e *linearGradient) Y1If(condition bool, value string) *linearGradient {
 	if condition {
-		e.Attribute("cellspacing", value)
+		e.Attribute("y1", value)
 	}
 	return e
 }
-
-// Tbody represents the <tbody> HTML element
-type tbody struct {
-	// Embeds the base Tag to inherit core HTML element functionality
-	*Tag
-}
-
-// Tbody creates a new tbody element
-// Allows optional child nodes to be passed during creation
-func Tbody(children ...Node) *tbody {
-	return &tbody{NewTag("tbody", false, children)}
-}
-
-// Td represents the <td> HTML element
-type td struct {
-	// Embeds the base Tag to inherit core HTML element functionality
-	*Tag
+
+// X2 sets the "x2" attribute
+// Returns the element itself to enable method chaining
+func (e *linearGradient) X2(value string) *linearGradient {
+	e.Attribute("x2", value)
+	return e
 }
 
-// Td creates a new td element
-// Allows optional child nodes to be passed during creation
-func Td(children ...Node) *td {
-	return &td{NewTag("td", false, children)}
+// X2If conditionally sets the "x2" attribute
+// Only sets the attribute if the condition is true
+func (e *linearGradient) X2If(condition bool, value string) *linearGradient {
+	if condition {
+		e.Attribute("x2", value)
+	}
+	return e
 }
 
-// Colspan sets the "colspan" attribute
+// Y2 sets the "y2" attribute
 // Returns the element itself to enable method chaining
-func (e *td) Colspan(value string) *td {
-	e.Attribute("colspan", value)
+func (e *linearGradient) Y2(value string) *linearGradient {
+	e.Attribute("y2", value)
 	return e
 }
 
-// ColspanIf conditionally sets the "colspan" attribute
+// Y2If conditionally sets the "y2" attribute
 // Only sets the attribute if the condition is true
-func (e *td) ColspanIf(condition bool, value string) *td {
+func (e *linearGradient) Y2If(condition bool, value string) *linearGradient {
 	if condition {
-		e.Attribute("colspan", value)
+		e.Attribute("y2", value)
 	}
 	return e
 }
 
-// Rowspan sets the "rowspan" attribute
+// GradientUnits sets the "gradientUnits" attribute
 // Returns the element itself to enable method chaining
-func (e *td) Rowspan(value string) *td {
-	e.Attribute("rowspan", value)
+func (e *linearGradient) GradientUnits(value string) *linearGradient {
+	e.Attribute("gradientUnits", value)
 	return e
 }
 
-// RowspanIf conditionally sets the "rowspan" attribute
+// GradientUnitsIf conditionally sets the "gradientUnits" attribute
 // Only sets the attribute if the condition is true
-func (e *td) RowspanIf(condition bool, value string) *td {
+func (e *linearGradient) GradientUnitsIf(condition bool, value string) *linearGradient {
 	if condition {
-		e.Attribute("rowspan", value)
+		e.Attribute("gradientUnits", value)
 	}
 	return e
 }
 
-// Headers sets the "headers" attribute
+// GradientTransform sets the "gradientTransform" attribute
 // Returns the element itself to enable method chaining
-func (e *td) Headers(value string) *td {
-	e.Attribute("headers", value)
+func (e *linearGradient) GradientTransform(value string) *linearGradient {
+	e.Attribute("gradientTransform", value)
 	return e
 }
 
-// HeadersIf conditionally sets the "headers" attribute
+// GradientTransformIf conditionally sets the "gradientTransform" attribute
 // Only sets the attribute if the condition is true
-func (e *td) HeadersIf(condition bool, value string) *td {
+func (e *linearGradient) GradientTransformIf(condition bool, value string) *linearGradient {
 	if condition {
-		e.Attribute("headers", value)
+		e.Attribute("gradientTransform", value)
 	}
 	return e
 }
 
-// Template represents the <template> HTML element
-type template struct {
-	// Embeds the base Tag to inherit core HTML element functionality
-	*Tag
+// SpreadMethod sets the "spreadMethod" attribute
+// Returns the element itself to enable method chaining
+func (e *linearGradient) SpreadMethod(value string) *linearGradient {
+	e.Attribute("spreadMethod", value)
+	return e
 }
 
-// Template creates a new template element
-// Allows optional child nodes to be passed during creation
-func Template(children ...Node) *template {
-	return &template{NewTag("template", false, children)}
+// SpreadMethodIf conditionally sets the "spreadMethod" attribute
+// Only sets the attribute if the condition is true
+func (e *linearGradient) SpreadMethodIf(condition bool, value string) *linearGradient {
+	if condition {
+		e.Attribute("spreadMethod", value)
+	}
+	return e
 }
 
-// Textarea represents the <textarea> HTML element
-type textarea struct {
+// RadialGradient represents the <radialGradient> HTML element
+type radialGradient struct {
 	// Embeds the base Tag to inherit core HTML element functionality
 	*Tag
 }
 
-// Textarea creates a new textarea element
+// RadialGradient creates a new radialGradient element
 // Allows optional child nodes to be passed during creation
-func Textarea(children ...Node) *textarea {
-	return &textarea{NewTag("textarea", false, children)}
+func RadialGradient(children ...Node) *radialGradient {
+	return &radialGradient{NewTag("radialGradient", false, children)}
 }
 
-// Rows sets the "rows" attribute
+// Cx sets the "cx" attribute
 // Returns the element itself to enable method chaining
-func (e *textarea) Rows(value string) *textarea {
-	e.Attribute("rows", value)
+func (e *radialGradient) Cx(value string) *radialGradient {
+	e.Attribute("cx", value)
 	return e
 }
 
-// RowsIf conditionally sets the "rows" attribute
+// CxIf conditionally sets the "cx" attribute
 // Only sets the attribute if the condition is true
-func (e *textarea) RowsIf(condition bool, value string) *textarea {
+func (e *radialGradient) CxIf(condition bool, value string) *radialGradient {
 	if condition {
-		e.Attribute("rows", value)
+		e.Attribute("cx", value)
 	}
 	return e
 }
 
-// Cols sets the "cols" attribute
+// Cy sets the "cy" attribute
 // Returns the element itself to enable method chaining
-func (e *textarea) Cols(value string) *textarea {
-	e.Attribute("cols", value)
+func (e *radialGradient) Cy(value string) *radialGradient {
+	e.Attribute("cy", value)
 	return e
 }
 
-// ColsIf conditionally sets the "cols" attribute
+// CyIf conditionally sets the "cy" attribute
 // Only sets the attribute if the condition is true
-func (e *textarea) ColsIf(condition bool, value string) *textarea {
+func (e *radialGradient) CyIf(condition bool, value string) *radialGradient {
 	if condition {
-		e.Attribute("cols", value)
+		e.Attribute("cy", value)
 	}
 	return e
 }
 
-// Maxlength sets the "maxlength" attribute
+// R sets the "r" attribute
 // Returns the element itself to enable method chaining
-func (e *textarea) Maxlength(value string) *textarea {
-	e.Attribute("maxlength", value)
+func (e *radialGradient) R(value string) *radialGradient {
+	e.Attribute("r", value)
 	return e
 }
 
-// MaxlengthIf conditionally sets the "maxlength" attribute
+// RIf conditionally sets the "r" attribute
 // Only sets the attribute if the condition is true
-func (e *textarea) MaxlengthIf(condition bool, value string) *textarea {
+func (e *radialGradient) RIf(condition bool, value string) *radialGradient {
 	if condition {
-		e.Attribute("maxlength", value)
+		e.Attribute("r", value)
 	}
 	return e
 }
 
-// Minlength sets the "minlength" attribute
+// Fx sets the "fx" attribute
 // Returns the element itself to enable method chaining
-func (e *textarea) Minlength(value string) *textarea {
-	e.Attribute("minlength", value)
+func (e *radialGradient) Fx(value string) *radialGradient {
+	e.Attribute("fx", value)
 	return e
 }
 
-// MinlengthIf conditionally sets the "minlength" attribute
+// FxIf conditionally sets the "fx" attribute
 // Only sets the attribute if the condition is true
-func (e *textarea) MinlengthIf(condition bool, value string) *textarea {
+func (e *radialGradient) FxIf(condition bool, value string) *radialGradient {
 	if condition {
-		e.Attribute("minlength", value)
+		e.Attribute("fx", value)
 	}
 	return e
 }
 
-// Wrap sets the "wrap" attribute
+// Fy sets the "fy" attribute
 // Returns the element itself to enable method chaining
-func (e *textarea) Wrap(value string) *textarea {
-	e.Attribute("wrap", value)
+func (e *radialGradient) Fy(value string) *radialGradient {
+	e.Attribute("fy", value)
 	return e
 }
 
-// WrapIf conditionally sets the "wrap" attribute
+// FyIf conditionally sets the "fy" attribute
 // Only sets the attribute if the condition is true
-func (e *textarea) WrapIf(condition bool, value string) *textarea {
+func (e *radialGradient) FyIf(condition bool, value string) *radialGradient {
 	if condition {
-		e.Attribute("wrap", value)
+		e.Attribute("fy", value)
 	}
 	return e
 }
 
-// Tfoot represents the <tfoot> HTML element
-type tfoot struct {
-	// Embeds the base Tag to inherit core HTML element functionality
-	*Tag
-}
-
-// Tfoot creates a new tfoot element
-// Allows optional child nodes to be passed during creation
-func Tfoot(children ...Node) *tfoot {
-	return &tfoot{NewTag("tfoot", false, children)}
-}
-
-// Th represents the <th> HTML element
-type th struct {
-	// Embeds the base Tag to inherit core HTML element functionality
-	*Tag
-}
-
-// Th creates a new th element
-// Allows optional child nodes to be passed during creation
-func Th(children ...Node) *th {
-	return &th{NewTag("th", false, children)}
-}
-
-// Scope sets the "scope" attribute
+// GradientUnits sets the "gradientUnits" attribute
 // Returns the element itself to enable method chaining
-func (e *th) Scope(value string) *th {
-	e.Attribute("scope", value)
+func (e *radialGradient) GradientUnits(value string) *radialGradient {
+	e.Attribute("gradientUnits", value)
 	return e
 }
 
-// ScopeIf conditionally sets the "scope" attribute
+// GradientUnitsIf conditionally sets the "gradientUnits" attribute
 // Only sets the attribute if the condition is true
-func (e *th) ScopeIf(condition bool, value string) *th {
+func (e *radialGradient) GradientUnitsIf(condition bool, value string) *radialGradient {
 	if condition {
-		e.Attribute("scope", value)
+		e.Attribute("gradientUnits", value)
 	}
 	return e
 }
 
-// Thead represents the <thead> HTML element
-type thead struct {
-	// Embeds the base Tag to inherit core HTML element functionality
-	*Tag
-}
-
-// Thead creates a new thead element
-// Allows optional child nodes to be passed during creation
-func Thead(children ...Node) *thead {
-	return &thead{NewTag("thead", false, children)}
-}
-
-// Time represents the <time> HTML element
-type time struct {
-	// Embeds the base Tag to inherit core HTML element functionality
-	*Tag
-}
-
-// Time creates a new time element
-// Allows optional child nodes to be passed during creation
-func Time(children ...Node) *time {
-	return &time{NewTag("time", false, children)}
-}
-
-// Datetime sets the "datetime" attribute
+// GradientTransform sets the "gradientTransform" attribute
 // Returns the element itself to enable method chaining
-func (e *time) Datetime(value string) *time {
-	e.Attribute("datetime", value)
+func (e *radialGradient) GradientTransform(value string) *radialGradient {
+	e.Attribute("gradientTransform", value)
 	return e
 }
 
-// DatetimeIf conditionally sets the "datetime" attribute
+// GradientTransformIf conditionally sets the "gradientTransform" attribute
 // Only sets the attribute if the condition is true
-func (e *time) DatetimeIf(condition bool, value string) *time {
+func (e *radialGradient) GradientTransformIf(condition bool, value string) *radialGradient {
 	if condition {
-		e.Attribute("datetime", value)
+		e.Attribute("gradientTransform", value)
 	}
 	return e
 }
 
-// Title represents the <title> HTML element
-type title struct {
-	// Embeds the base Tag to inherit core HTML element functionality
-	*Tag
-}
-
-// Title creates a new title element
-// Allows optional child nodes to be passed during creation
-func Title(children ...Node) *title {
-	return &title{NewTag("title", false, children)}
-}
-
-// Tr represents the <tr> HTML element
-type tr struct {
-	// Embeds the base Tag to inherit core HTML element functionality
-	*Tag
+// SpreadMethod sets the "spreadMethod" attribute
+// Returns the element itself to enable method chaining
+func (e *radialGradient) SpreadMethod(value string) *radialGradient {
+	e.Attribute("spreadMethod", value)
+	return e
 }
 
-// Tr creates a new tr element
-// Allows optional child nodes to be passed during creation
-func Tr(children ...Node) *tr {
-	return &tr{NewTag("tr", false, children)}
+// SpreadMethodIf conditionally sets the "spreadMethod" attribute
+// Only sets the attribute if the condition is true
+func (e *radialGradient) SpreadMethodIf(condition bool, value string) *radialGradient {
+	if condition {
+		e.Attribute("spreadMethod", value)
+	}
+	return e
 }
 
-// Track represents the <track> HTML element
-type track struct {
+// Stop represents the <stop> HTML element
+type stop struct {
 	// Embeds the base Tag to inherit core HTML element functionality
 	*Tag
 }
 
-// Track creates a new track element
+// Stop creates a new stop element
 // Allows optional child nodes to be passed during creation
-func Track(children ...Node) *track {
-	return &track{NewTag("track", true, children)}
+func Stop(children ...Node) *stop {
+	return &stop{NewTag("stop", false, children)}
 }
 
-// U represents the <u> HTML element
-type u struct {
-	// Embeds the base Tag to inherit core HTML element functionality
-	*Tag
+// Offset sets the "offset" attribute
+// Returns the element itself to enable method chaining
+func (e *stop) Offset(value string) *stop {
+	e.Attribute("offset", value)
+	return e
 }
 
-// U creates a new u element
-// Allows optional child nodes to be passed during creation
-func U(children ...Node) *u {
-	return &u{NewTag("u", false, children)}
+// OffsetIf conditionally sets the "offset" attribute
+// Only sets the attribute if the condition is true
+func (e *stop) OffsetIf(condition bool, value string) *stop {
+	if condition {
+		e.Attribute("offset", value)
+	}
+	return e
 }
 
-// Ul represents the <ul> HTML element
-type ul struct {
-	// Embeds the base Tag to inherit core HTML element functionality
-	*Tag
+// StopColor sets the "stop-color" attribute
+// Returns the element itself to enable method chaining
+func (e *stop) StopColor(value string) *stop {
+	e.Attribute("stop-color", value)
+	return e
 }
 
-// Ul creates a new ul element
-// Allows optional child nodes to be passed during creation
-func Ul(children ...Node) *ul {
-	return &ul{NewTag("ul", false, children)}
+// StopColorIf conditionally sets the "stop-color" attribute
+// Only sets the attribute if the condition is true
+func (e *stop) StopColorIf(condition bool, value string) *stop {
+	if condition {
+		e.Attribute("stop-color", value)
+	}
+	return e
 }
 
-// Var represents the <var> HTML element
-type var_ struct {
-	// Embeds the base Tag to inherit core HTML element functionality
-	*Tag
+// StopOpacity sets the "stop-opacity" attribute
+// Returns the element itself to enable method chaining
+func (e *stop) StopOpacity(value string) *stop {
+	e.Attribute("stop-opacity", value)
+	return e
 }
 
-// Var creates a new var element
-// Allows optional child nodes to be passed during creation
-func Var(children ...Node) *var_ {
-	return &var_{NewTag("var", false, children)}
+// StopOpacityIf conditionally sets the "stop-opacity" attribute
+// Only sets the attribute if the condition is true
+func (e *stop) StopOpacityIf(condition bool, value string) *stop {
+	if condition {
+		e.Attribute("stop-opacity", value)
+	}
+	return e
 }
 
-// Video represents the <video> HTML element
-type video struct {
+// ClipPath represents the <clipPath> HTML element
+type clipPath struct {
 	// Embeds the base Tag to inherit core HTML element functionality
 	*Tag
 }
 
-// Video creates a new video element
+// ClipPath creates a new clipPath element
 // Allows optional child nodes to be passed during creation
-func Video(children ...Node) *video {
-	return &video{NewTag("video", false, children)}
+func ClipPath(children ...Node) *clipPath {
+	return &clipPath{NewTag("clipPath", false, children)}
 }
 
-// Poster sets the "poster" attribute
+// ClipPathUnits sets the "clipPathUnits" attribute
 // Returns the element itself to enable method chaining
-func (e *video) Poster(value string) *video {
-	e.Attribute("poster", value)
+func (e *clipPath) ClipPathUnits(value string) *clipPath {
+	e.Attribute("clipPathUnits", value)
 	return e
 }
 
-// PosterIf conditionally sets the "poster" attribute
+// ClipPathUnitsIf conditionally sets the "clipPathUnits" attribute
 // Only sets the attribute if the condition is true
-func (e *video) PosterIf(condition bool, value string) *video {
+func (e *clipPath) ClipPathUnitsIf(condition bool, value string) *clipPath {
 	if condition {
-		e.Attribute("poster", value)
+		e.Attribute("clipPathUnits", value)
 	}
 	return e
 }
 
-// Wbr represents the <wbr> HTML element
-type wbr struct {
+// Mask represents the <mask> HTML element
+type mask struct {
 	// Embeds the base Tag to inherit core HTML element functionality
 	*Tag
 }
 
-// Wbr creates a new wbr element
+// Mask creates a new mask element
 // Allows optional child nodes to be passed during creation
-func Wbr(children ...Node) *wbr {
-	return &wbr{NewTag("wbr", true, children)}
+func Mask(children ...Node) *mask {
+	return &mask{NewTag("mask", false, children)}
 }
 
-// Circle represents the <circle> HTML element
-type circle struct {
-	// Embeds the base Tag to inherit core HTML element functionality
-	*Tag
+// X sets the "x" attribute
+// Returns the element itself to enable method chaining
+func (e *mask) X(value string) *mask {
+	e.Attribute("x", value)
+	return e
 }
 
-// Circle creates a new circle element
-// Allows optional child nodes to be passed during creation
-func Circle(children ...Node) *circle {
-	return &circle{NewTag("circle", false, children)}
+// XIf conditionally sets the "x" attribute
+// Only sets the attribute if the condition is true
+func (e *mask) XIf(condition bool, value string) *mask {
+	if condition {
+		e.Attribute("x", value)
+	}
+	return e
 }
 
-// Cx sets the "cx" attribute
+// Y sets the "y" attribute
 // Returns the element itself to enable method chaining
-func (e *circle) Cx(value string) *circle {
-	e.Attribute("cx", value)
+func (e *mask) Y(value string) *mask {
+	e.Attribute("y", value)
 	return e
 }
 
-// CxIf conditionally sets the "cx" attribute
+// YIf conditionally sets the "y" attribute
 // Only sets the attribute if the condition is true
-func (e *circle) CxIf(condition bool, value string) *circle {
+func (e *mask) YIf(condition bool, value string) *mask {
 	if condition {
-		e.Attribute("cx", value)
+		e.Attribute("y", value)
 	}
 	return e
 }
 
-// Cy sets the "cy" attribute
+// Width sets the "width" attribute
 // Returns the element itself to enable method chaining
-func (e *circle) Cy(value string) *circle {
-	e.Attribute("cy", value)
+func (e *mask) Width(value string) *mask {
+	e.Attribute("width", value)
 	return e
 }
 
-// CyIf conditionally sets the "cy" attribute
+// WidthIf conditionally sets the "width" attribute
 // Only sets the attribute if the condition is true
-func (e *circle) CyIf(condition bool, value string) *circle {
+func (e *mask) WidthIf(condition bool, value string) *mask {
 	if condition {
-		e.Attribute("cy", value)
+		e.Attribute("width", value)
 	}
 	return e
 }
 
-// R sets the "r" attribute
+// WidthInt sets the "width" attribute to an integer value
 // Returns the element itself to enable method chaining
-func (e *circle) R(value string) *circle {
-	e.Attribute("r", value)
+func (e *mask) WidthInt(value int) *mask {
+	e.AttributeInt("width", value)
 	return e
 }
 
-// RIf conditionally sets the "r" attribute
+// Height sets the "height" attribute
+// Returns the element itself to enable method chaining
+func (e *mask) Height(value string) *mask {
+	e.Attribute("height", value)
+	return e
+}
+
+// HeightIf conditionally sets the "height" attribute
 // Only sets the attribute if the condition is true
-func (e *circle) RIf(condition bool, value string) *circle {
+func (e *mask) HeightIf(condition bool, value string) *mask {
 	if condition {
-		e.Attribute("r", value)
+		e.Attribute("height", value)
 	}
 	return e
 }
 
-// Fill sets the "fill" attribute
+// HeightInt sets the "height" attribute to an integer value
 // Returns the element itself to enable method chaining
-func (e *circle) Fill(value string) *circle {
-	e.Attribute("fill", value)
+func (e *mask) HeightInt(value int) *mask {
+	e.AttributeInt("height", value)
 	return e
 }
 
-// FillIf conditionally sets the "fill" attribute
+// MaskUnits sets the "maskUnits" attribute
+// Returns the element itself to enable method chaining
+func (e *mask) MaskUnits(value string) *mask {
+	e.Attribute("maskUnits", value)
+	return e
+}
+
+// MaskUnitsIf conditionally sets the "maskUnits" attribute
 // Only sets the attribute if the condition is true
-func (e *circle) FillIf(condition bool, value string) *circle {
+func (e *mask) MaskUnitsIf(condition bool, value string) *mask {
 	if condition {
-		e.Attribute("fill", value)
+		e.Attribute("maskUnits", value)
 	}
 	return e
 }
 
-// Stroke sets the "stroke" attribute
+// MaskContentUnits sets the "maskContentUnits" attribute
 // Returns the element itself to enable method chaining
-func (e *circle) Stroke(value string) *circle {
-	e.Attribute("stroke", value)
+func (e *mask) MaskContentUnits(value string) *mask {
+	e.Attribute("maskContentUnits", value)
 	return e
 }
 
-// StrokeIf conditionally sets the "stroke" attribute
+// MaskContentUnitsIf conditionally sets the "maskContentUnits" attribute
 // Only sets the attribute if the condition is true
-func (e *circle) StrokeIf(condition bool, value string) *circle {
+func (e *mask) MaskContentUnitsIf(condition bool, value string) *mask {
 	if condition {
-		e.Attribute("stroke", value)
+		e.Attribute("maskContentUnits", value)
 	}
 	return e
 }
 
-// Ellipse represents the <ellipse> HTML element
-type ellipse struct {
+// Pattern represents the <pattern> HTML element
+type pattern struct {
 	// Embeds the base Tag to inherit core HTML element functionality
 	*Tag
 }
 
-// Ellipse creates a new ellipse element
+// Pattern creates a new pattern element
 // Allows optional child nodes to be passed during creation
-func Ellipse(children ...Node) *ellipse {
-	return &ellipse{NewTag("ellipse", false, children)}
+func Pattern(children ...Node) *pattern {
+	return &pattern{NewTag("pattern", false, children)}
 }
 
-// G represents the <g> HTML element
-type g struct {
-	// Embeds the base Tag to inherit core HTML element functionality
-	*Tag
+// X sets the "x" attribute
+// Returns the element itself to enable method chaining
+func (e *pattern) X(value string) *pattern {
+	e.Attribute("x", value)
+	return e
 }
 
-// G creates a new g element
-// Allows optional child nodes to be passed during creation
-func G(children ...Node) *g {
-	return &g{NewTag("g", false, children)}
+// XIf conditionally sets the "x" attribute
+// Only sets the attribute if the condition is true
+func (e *pattern) XIf(condition bool, value string) *pattern {
+	if condition {
+		e.Attribute("x", value)
+	}
+	return e
 }
 
-// Transform sets the "transform" attribute
+// Y sets the "y" attribute
 // Returns the element itself to enable method chaining
-func (e *g) Transform(value string) *g {
-	e.Attribute("transform", value)
+func (e *pattern) Y(value string) *pattern {
+	e.Attribute("y", value)
 	return e
 }
 
-// TransformIf conditionally sets the "transform" attribute
+// YIf conditionally sets the "y" attribute
 // Only sets the attribute if the condition is true
-func (e *g) TransformIf(condition bool, value string) *g {
+func (e *pattern) YIf(condition bool, value string) *pattern {
 	if condition {
-		e.Attribute("transform", value)
+		e.Attribute("y", value)
 	}
 	return e
 }
 
-// Line represents the <line> HTML element
-type line struct {
-	// Embeds the base Tag to inherit core HTML element functionality
-	*Tag
+// Width sets the "width" attribute
+// Returns the element itself to enable method chaining
+func (e *pattern) Width(value string) *pattern {
+	e.Attribute("width", value)
+	return e
 }
 
-// Line creates a new line element
-// Allows optional child nodes to be passed during creation
-func Line(children ...Node) *line {
-	return &line{NewTag("line", false, children)}
+// WidthIf conditionally sets the "width" attribute
+// Only sets the attribute if the condition is true
+func (e *pattern) WidthIf(condition bool, value string) *pattern {
+	if condition {
+		e.Attribute("width", value)
+	}
+	return e
 }
 
-// X1 sets the "x1" attribute
+// WidthInt sets the "width" attribute to an integer value
 // Returns the element itself to enable method chaining
-func (e *line) X1(value string) *line {
-	e.Attribute("x1", value)
+func (e *pattern) WidthInt(value int) *pattern {
+	e.AttributeInt("width", value)
 	return e
 }
 
-// X1If conditionally sets the "x1" attribute
+// Height sets the "height" attribute
+// Returns the element itself to enable method chaining
+func (e *pattern) Height(value string) *pattern {
+	e.Attribute("height", value)
+	return e
+}
+
+// HeightIf conditionally sets the "height" attribute
 // Only sets the attribute if the condition is true
-func (e *line) X1If(condition bool, value string) *line {
+func (e *pattern) HeightIf(condition bool, value string) *pattern {
 	if condition {
-		e.Attribute("x1", value)
+		e.Attribute("height", value)
 	}
 	return e
 }
 
-// Y1 sets the "y1" attribute
+// HeightInt sets the "height" attribute to an integer value
 // Returns the element itself to enable method chaining
-func (e *line) Y1(value string) *line {
-	e.Attribute("y1", value)
+func (e *pattern) HeightInt(value int) *pattern {
+	e.AttributeInt("height", value)
 	return e
 }
 
-// Y1If conditionally sets the "y1" attribute
+// PatternUnits sets the "patternUnits" attribute
+// Returns the element itself to enable method chaining
+func (e *pattern) PatternUnits(value string) *pattern {
+	e.Attribute("patternUnits", value)
+	return e
+}
+
+// PatternUnitsIf conditionally sets the "patternUnits" attribute
 // Only sets the attribute if the condition is true
-func (e *line) Y1If(condition bool, value string) *line {
+func (e *pattern) PatternUnitsIf(condition bool, value string) *pattern {
 	if condition {
-		e.Attribute("y1", value)
+		e.Attribute("patternUnits", value)
 	}
 	return e
 }
 
-// X2 sets the "x2" attribute
+// PatternContentUnits sets the "patternContentUnits" attribute
 // Returns the element itself to enable method chaining
-func (e *line) X2(value string) *line {
-	e.Attribute("x2", value)
+func (e *pattern) PatternContentUnits(value string) *pattern {
+	e.Attribute("patternContentUnits", value)
 	return e
 }
 
-// X2If conditionally sets the "x2" attribute
+// PatternContentUnitsIf conditionally sets the "patternContentUnits" attribute
 // Only sets the attribute if the condition is true
-func (e *line) X2If(condition bool, value string) *line {
+func (e *pattern) PatternContentUnitsIf(condition bool, value string) *pattern {
 	if condition {
-		e.Attribute("x2", value)
+		e.Attribute("patternContentUnits", value)
 	}
 	return e
 }
 
-// Y2 sets the "y2" attribute
+// PatternTransform sets the "patternTransform" attribute
 // Returns the element itself to enable method chaining
-func (e *line) Y2(value string) *line {
-	e.Attribute("y2", value)
+func (e *pattern) PatternTransform(value string) *pattern {
+	e.Attribute("patternTransform", value)
 	return e
 }
 
-// Y2If conditionally sets the "y2" attribute
+// PatternTransformIf conditionally sets the "patternTransform" attribute
 // Only sets the attribute if the condition is true
-func (e *line) Y2If(condition bool, value string) *line {
+func (e *pattern) PatternTransformIf(condition bool, value string) *pattern {
 	if condition {
-		e.Attribute("y2", value)
+		e.Attribute("patternTransform", value)
 	}
 	return e
 }
 
-// StrokeWidth sets the "stroke-width" attribute
+// ViewBox sets the "viewBox" attribute
 // Returns the element itself to enable method chaining
-func (e *line) StrokeWidth(value string) *line {
-	e.Attribute("stroke-width", value)
+func (e *pattern) ViewBox(value string) *pattern {
+	e.Attribute("viewBox", value)
 	return e
 }
 
-// StrokeWidthIf conditionally sets the "stroke-width" attribute
+// ViewBoxIf conditionally sets the "viewBox" attribute
 // Only sets the attribute if the condition is true
-func (e *line) StrokeWidthIf(condition bool, value string) *line {
+func (e *pattern) ViewBoxIf(condition bool, value string) *pattern {
 	if condition {
-		e.Attribute("stroke-width", value)
+		e.Attribute("viewBox", value)
 	}
 	return e
 }
 
-// Path represents the <path> HTML element
-type path struct {
+// Symbol represents the <symbol> HTML element
+type symbol struct {
 	// Embeds the base Tag to inherit core HTML element functionality
 	*Tag
 }
 
-// Path creates a new path element
+// Symbol creates a new symbol element
 // Allows optional child nodes to be passed during creation
-func Path(children ...Node) *path {
-	return &path{NewTag("path", false, children)}
+func Symbol(children ...Node) *symbol {
+	return &symbol{NewTag("symbol", false, children)}
 }
 
-// D sets the "d" attribute
+// ViewBox sets the "viewBox" attribute
 // Returns the element itself to enable method chaining
-func (e *path) D(value string) *path {
-	e.Attribute("d", value)
+func (e *symbol) ViewBox(value string) *symbol {
+	e.Attribute("viewBox", value)
 	return e
 }
 
-// DIf conditionally sets the "d" attribute
+// ViewBoxIf conditionally sets the "viewBox" attribute
 // Only sets the attribute if the condition is true
-func (e *path) DIf(condition bool, value string) *path {
+func (e *symbol) ViewBoxIf(condition bool, value string) *symbol {
 	if condition {
-		e.Attribute("d", value)
+		e.Attribute("viewBox", value)
 	}
 	return e
 }
 
-// Polygon represents the <polygon> HTML element
-type polygon struct {
+// PreserveAspectRatio sets the "preserveAspectRatio" attribute
+// Returns the element itself to enable method chaining
+func (e *symbol) PreserveAspectRatio(value string) *symbol {
+	e.Attribute("preserveAspectRatio", value)
+	return e
+}
+
+// PreserveAspectRatioIf conditionally sets the "preserveAspectRatio" attribute
+// Only sets the attribute if the condition is true
+func (e *symbol) PreserveAspectRatioIf(condition bool, value string) *symbol {
+	if condition {
+		e.Attribute("preserveAspectRatio", value)
+	}
+	return e
+}
+
+// Math represents the <math> MathML element
+type math struct {
 	// Embeds the base Tag to inherit core HTML element functionality
 	*Tag
 }
 
-// Polygon creates a new polygon element
+// Math creates a new math element
 // Allows optional child nodes to be passed during creation
-func Polygon(children ...Node) *polygon {
-	return &polygon{NewTag("polygon", false, children)}
+func Math(children ...Node) *math {
+	return &math{NewTag("math", false, children)}
 }
 
-// Points sets the "points" attribute
+// Display sets the "display" attribute
 // Returns the element itself to enable method chaining
-func (e *polygon) Points(value string) *polygon {
-	e.Attribute("points", value)
+func (e *math) Display(value string) *math {
+	e.Attribute("display", value)
 	return e
 }
 
-// PointsIf conditionally sets the "points" attribute
+// DisplayIf conditionally sets the "display" attribute
 // Only sets the attribute if the condition is true
-func (e *polygon) PointsIf(condition bool, value string) *polygon {
+func (e *math) DisplayIf(condition bool, value string) *math {
 	if condition {
-		e.Attribute("points", value)
+		e.Attribute("display", value)
 	}
 	return e
 }
 
-// Polyline represents the <polyline> HTML element
-type polyline struct {
+// Mrow represents the <mrow> MathML element
+type mrow struct {
 	// Embeds the base Tag to inherit core HTML element functionality
 	*Tag
 }
 
-// Polyline creates a new polyline element
+// Mrow creates a new mrow element
 // Allows optional child nodes to be passed during creation
-func Polyline(children ...Node) *polyline {
-	return &polyline{NewTag("polyline", false, children)}
+func Mrow(children ...Node) *mrow {
+	return &mrow{NewTag("mrow", false, children)}
 }
 
-// Rect represents the <rect> HTML element
-type rect struct {
+// Mi represents the <mi> MathML element
+type mi struct {
 	// Embeds the base Tag to inherit core HTML element functionality
 	*Tag
 }
 
-// Rect creates a new rect element
+// Mi creates a new mi element
 // Allows optional child nodes to be passed during creation
-func Rect(children ...Node) *rect {
-	return &rect{NewTag("rect", false, children)}
+func Mi(children ...Node) *mi {
+	return &mi{NewTag("mi", false, children)}
 }
 
-// X sets the "x" attribute
-// Returns the element itself to enable method chaining
-func (e *rect) X(value string) *rect {
-	e.Attribute("x", value)
-	return e
+// Mn represents the <mn> MathML element
+type mn struct {
+	// Embeds the base Tag to inherit core HTML element functionality
+	*Tag
 }
 
-// XIf conditionally sets the "x" attribute
-// Only sets the attribute if the condition is true
-func (e *rect) XIf(condition bool, value string) *rect {
-	if condition {
-		e.Attribute("x", value)
-	}
-	return e
+// Mn creates a new mn element
+// Allows optional child nodes to be passed during creation
+func Mn(children ...Node) *mn {
+	return &mn{NewTag("mn", false, children)}
 }
 
-// Y sets the "y" attribute
-// Returns the element itself to enable method chaining
-func (e *rect) Y(value string) *rect {
-	e.Attribute("y", value)
-	return e
+// Mo represents the <mo> MathML element
+type mo struct {
+	// Embeds the base Tag to inherit core HTML element functionality
+	*Tag
 }
 
-// YIf conditionally sets the "y" attribute
-// Only sets the attribute if the condition is true
-func (e *rect) YIf(condition bool, value string) *rect {
-	if condition {
-		e.Attribute("y", value)
-	}
-	return e
+// Mo creates a new mo element
+// Allows optional child nodes to be passed during creation
+func Mo(children ...Node) *mo {
+	return &mo{NewTag("mo", false, children)}
 }
 
-// Rx sets the "rx" attribute
-// Returns the element itself to enable method chaining
-func (e *rect) Rx(value string) *rect {
-	e.Attribute("rx", value)
-	return e
+// Msup represents the <msup> MathML element
+type msup struct {
+	// Embeds the base Tag to inherit core HTML element functionality
+	*Tag
 }
 
-// RxIf conditionally sets the "rx" attribute
-// Only sets the attribute if the condition is true
-func (e *rect) RxIf(condition bool, value string) *rect {
-	if condition {
-		e.Attribute("rx", value)
-	}
-	return e
+// Msup creates a new msup element
+// Allows optional child nodes to be passed during creation
+func Msup(children ...Node) *msup {
+	return &msup{NewTag("msup", false, children)}
 }
 
-// Ry sets the "ry" attribute
-// Returns the element itself to enable method chaining
-func (e *rect) Ry(value string) *rect {
-	e.Attribute("ry", value)
-	return e
+// Msub represents the <msub> MathML element
+type msub struct {
+	// Embeds the base Tag to inherit core HTML element functionality
+	*Tag
 }
 
-// RyIf conditionally sets the "ry" attribute
-// Only sets the attribute if the condition is true
-func (e *rect) RyIf(condition bool, value string) *rect {
-	if condition {
-		e.Attribute("ry", value)
-	}
-	return e
+// Msub creates a new msub element
+// Allows optional child nodes to be passed during creation
+func Msub(children ...Node) *msub {
+	return &msub{NewTag("msub", false, children)}
 }
 
-// Use represents the <use> HTML element
-type use struct {
+// Mfrac represents the <mfrac> MathML element
+type mfrac struct {
 	// Embeds the base Tag to inherit core HTML element functionality
 	*Tag
 }
 
-// Use creates a new use element
+// Mfrac creates a new mfrac element
 // Allows optional child nodes to be passed during creation
-func Use(children ...Node) *use {
-	return &use{NewTag("use", false, children)}
+func Mfrac(children ...Node) *mfrac {
+	return &mfrac{NewTag("mfrac", false, children)}
+}
+
+// Msqrt represents the <msqrt> MathML element
+type msqrt struct {
+	// Embeds the base Tag to inherit core HTML element functionality
+	*Tag
+}
+
+// Msqrt creates a new msqrt element
+// Allows optional child nodes to be passed during creation
+func Msqrt(children ...Node) *msqrt {
+	return &msqrt{NewTag("msqrt", false, children)}
+}
+
+// Mtext represents the <mtext> MathML element
+type mtext struct {
+	// Embeds the base Tag to inherit core HTML element functionality
+	*Tag
+}
+
+// Mtext creates a new mtext element
+// Allows optional child nodes to be passed during creation
+func Mtext(children ...Node) *mtext {
+	return &mtext{NewTag("mtext", false, children)}
 }
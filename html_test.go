@@ -20,6 +20,10 @@
 package html_test
 
 import (
+	"context"
+	"errors"
+	"io"
+	"strconv"
 	"strings"
 	"testing"
 
@@ -78,3 +82,1208 @@ func TestRender(t *testing.T) {
 		t.Errorf("expected: \"%s\"; got: \"%s\"", expected, got)
 	}
 }
+
+func TestVoidElements(t *testing.T) {
+	tests := []struct {
+		node Node
+		name string
+	}{
+		{Area(), "area"}, {Base(), "base"}, {Br(), "br"}, {Col(), "col"}, {Embed(), "embed"},
+		{Hr(), "hr"}, {Img(), "img"}, {Input(), "input"}, {Link(), "link"}, {Meta(), "meta"},
+		{Param(), "param"}, {Source(), "source"}, {Track(), "track"}, {Wbr(), "wbr"},
+	}
+
+	for _, test := range tests {
+		expected := "<" + test.name + "/>"
+		if got := MustRenderString(test.node); got != expected {
+			t.Errorf("expected: \"%s\"; got: \"%s\"", expected, got)
+		}
+		if got := MustRenderString(NewTagWith(test.name)); got != expected {
+			t.Errorf("NewTagWith(%q): expected: \"%s\"; got: \"%s\"", test.name, expected, got)
+		}
+	}
+}
+
+func TestAttributeEscaping(t *testing.T) {
+	tests := []struct {
+		name     string
+		node     Node
+		expected string
+	}{
+		{
+			name:     "quotes in href",
+			node:     A(Text("link")).Href(`" onmouseover="alert(1)`),
+			expected: `<a href="&#34; onmouseover=&#34;alert(1)">link</a>`,
+		},
+		{
+			name:     "ampersand in attribute",
+			node:     Div().Attribute("data-query", "a=1&b=2"),
+			expected: `<div data-query="a=1&amp;b=2"></div>`,
+		},
+		{
+			name:     "angle brackets in class",
+			node:     Div().Class("<script>", "x"),
+			expected: `<div class="&lt;script&gt; x"></div>`,
+		},
+		{
+			name:     "single quotes in attribute",
+			node:     Div().Attribute("title", "it's"),
+			expected: `<div title="it&#39;s"></div>`,
+		},
+		{
+			name:     "multiple attributes render sorted",
+			node:     A().Href("/").Class("btn").Attribute("aria-label", "home"),
+			expected: `<a aria-label="home" class="btn" href="/"></a>`,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			sb := &strings.Builder{}
+			if err := test.node.Render(sb); err != nil {
+				t.Fatal(err)
+			}
+			if got := sb.String(); got != test.expected {
+				t.Errorf("expected: \"%s\"; got: \"%s\"", test.expected, got)
+			}
+		})
+	}
+}
+
+func TestWriteTo(t *testing.T) {
+	nodes := []Node{
+		Document(HTML(Body(P(Text("Hello"))))),
+		Group(Text("a < b"), Raw("<hr/>")),
+		Div(Span(Text("nested")).Class("x")).Attribute("id", "main"),
+		Text("Tom & Jerry"),
+		Raw("<!-- raw -->"),
+	}
+
+	for _, node := range nodes {
+		wt, ok := node.(io.WriterTo)
+		if !ok {
+			t.Fatalf("%T does not implement io.WriterTo", node)
+		}
+
+		sb := &strings.Builder{}
+		n, err := wt.WriteTo(sb)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if n != int64(sb.Len()) {
+			t.Errorf("%T: expected %d bytes written; got: %d", node, sb.Len(), n)
+		}
+
+		expected := &strings.Builder{}
+		if err := node.Render(expected); err != nil {
+			t.Fatal(err)
+		}
+		if expected.String() != sb.String() {
+			t.Errorf("expected: \"%s\"; got: \"%s\"", expected.String(), sb.String())
+		}
+	}
+}
+
+// localeNode is a custom ContextNode reading a request-scoped value
+type localeNode struct{}
+
+type localeKey struct{}
+
+func (l localeNode) Render(w io.Writer) error {
+	return l.RenderContext(context.Background(), w)
+}
+
+func (localeNode) RenderContext(ctx context.Context, w io.Writer) error {
+	locale, _ := ctx.Value(localeKey{}).(string)
+	return Text(locale).Render(w)
+}
+
+func TestRenderContext(t *testing.T) {
+	ctx := context.WithValue(context.Background(), localeKey{}, "fr")
+	doc := Div(IfFunc(true, func() Node {
+		return Span(localeNode{})
+	}))
+
+	sb := &strings.Builder{}
+	if err := RenderContext(ctx, doc, sb); err != nil {
+		t.Fatal(err)
+	}
+	if expected := "<div><span>fr</span></div>"; sb.String() != expected {
+		t.Errorf("expected: \"%s\"; got: \"%s\"", expected, sb.String())
+	}
+
+	sb.Reset()
+	if err := doc.Render(sb); err != nil {
+		t.Fatal(err)
+	}
+	if expected := "<div><span></span></div>"; sb.String() != expected {
+		t.Errorf("expected: \"%s\"; got: \"%s\"", expected, sb.String())
+	}
+}
+
+func TestRenderContextCancel(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+
+	items := make([]int, 1000)
+	rendered := 0
+	list := Ul(Map(items, func(int) Node {
+		rendered++
+		if rendered == 10 {
+			cancel()
+		}
+		return Li()
+	}))
+
+	err := RenderContext(ctx, list, io.Discard)
+	if !errors.Is(err, context.Canceled) {
+		t.Errorf("expected context.Canceled; got: %v", err)
+	}
+	if rendered != 10 {
+		t.Errorf("expected rendering to stop after 10 items; got: %d", rendered)
+	}
+}
+
+func TestComment(t *testing.T) {
+	tests := []struct {
+		node     Node
+		expected string
+	}{
+		{Comment("build 42"), "<!--build 42-->"},
+		{Commentf("generated at %d", 2025), "<!--generated at 2025-->"},
+		{Comment(""), "<!---->"},
+		{Comment("oops --><script>alert(1)</script>"), "<!--oops --&gt;<script>alert(1)</script>-->"},
+		{Comment("[if IE]><p>Old browser</p><![endif]"), "<!--[if IE]><p>Old browser</p><![endif]-->"},
+		{Comment("a--!>b<!--c"), "<!--a--!&gt;b&lt;!--c-->"},
+		{Comment("<!-->"), "<!--&lt;!--&gt;-->"},
+		{Comment(">x"), "<!--&gt;x-->"},
+		{Comment("->x"), "<!---&gt;x-->"},
+		{Comment("x<!-"), "<!--x&lt;!--->"},
+		{Div(Comment("marker"), Text("x")), "<div><!--marker-->x</div>"},
+	}
+
+	for _, test := range tests {
+		got, err := RenderString(test.node)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if got != test.expected {
+			t.Errorf("expected: \"%s\"; got: \"%s\"", test.expected, got)
+		}
+	}
+}
+
+func TestAria(t *testing.T) {
+	tests := []struct {
+		node     Node
+		expected string
+	}{
+		{Button(Text("x")).Aria("label", "Close").Aria("expanded", "false"), `<button aria-expanded="false" aria-label="Close">x</button>`},
+		{Span().Aria("hidden", "true"), `<span aria-hidden="true"></span>`},
+		{Div().AriaIf(false, "hidden", "true"), `<div></div>`},
+		{Div().Aria("label", ""), `<div></div>`},
+		{Div().Aria("label", `"quoted"`), `<div aria-label="&#34;quoted&#34;"></div>`},
+		{Nav().Role("navigation"), `<nav role="navigation"></nav>`},
+		{Div().RoleIf(false, "alert"), `<div></div>`},
+	}
+
+	for _, test := range tests {
+		got, err := RenderString(test.node)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if got != test.expected {
+			t.Errorf("expected: \"%s\"; got: \"%s\"", test.expected, got)
+		}
+	}
+}
+
+func TestGlobalAttributes(t *testing.T) {
+	tests := []struct {
+		node     Node
+		expected string
+	}{
+		{Div().Style("color:red").ID("main"), `<div id="main" style="color:red"></div>`},
+		{P().Title("tip").Hidden("hidden").Tabindex("0"), `<p hidden="hidden" tabindex="0" title="tip"></p>`},
+		{Span().Lang("fr").Dir("ltr").Translate("no"), `<span dir="ltr" lang="fr" translate="no"></span>`},
+		{Section().Contenteditable("true").Draggable("false").Spellcheck("true"), `<section contenteditable="true" draggable="false" spellcheck="true"></section>`},
+		{Button().Accesskey("s"), `<button accesskey="s"></button>`},
+		{A().Href("/").ID("home").StyleIf(false, "x"), `<a href="/" id="home"></a>`},
+		{A().ID("home").TitleIf(true, "Home").Href("/"), `<a href="/" id="home" title="Home"></a>`},
+		{HTML().Lang("en").ClassIf(true, "dark"), `<html class="dark" lang="en"></html>`},
+		{HTML().Classes("a", "a", " b "), `<html class="a b"></html>`},
+	}
+
+	for _, test := range tests {
+		got, err := RenderString(test.node)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if got != test.expected {
+			t.Errorf("expected: \"%s\"; got: \"%s\"", test.expected, got)
+		}
+	}
+}
+
+func TestScriptSrcIf(t *testing.T) {
+	got, err := RenderString(Script().SrcIf(true, "/app.js"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if expected := `<script src="/app.js"></script>`; got != expected {
+		t.Errorf("expected: \"%s\"; got: \"%s\"", expected, got)
+	}
+	if !strings.Contains(got, "src=") {
+		t.Errorf("expected lowercase src attribute; got: \"%s\"", got)
+	}
+}
+
+func TestInputNameIfIdIf(t *testing.T) {
+	tests := []struct {
+		node     Node
+		expected string
+	}{
+		{Input().NameIf(false, "x"), `<input/>`},
+		{Input().IdIf(false, "x"), `<input/>`},
+		{Input().NameIf(true, "email").IdIf(true, "email"), `<input id="email" name="email"/>`},
+	}
+
+	for _, test := range tests {
+		got, err := RenderString(test.node)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if got != test.expected {
+			t.Errorf("expected: \"%s\"; got: \"%s\"", test.expected, got)
+		}
+	}
+}
+
+func TestClassMap(t *testing.T) {
+	tests := []struct {
+		node     Node
+		expected string
+	}{
+		{Div().ClassMap(map[string]bool{"active": true, "disabled": false, "btn": true}), `<div class="active btn"></div>`},
+		{Div().Class("card").ClassMap(map[string]bool{"shadow": true}), `<div class="card shadow"></div>`},
+		{Div().ClassMap(map[string]bool{"hidden": false}), `<div></div>`},
+		{Div().ClassMap(nil), `<div></div>`},
+	}
+
+	for _, test := range tests {
+		got, err := RenderString(test.node)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if got != test.expected {
+			t.Errorf("expected: \"%s\"; got: \"%s\"", test.expected, got)
+		}
+	}
+}
+
+func TestAddClass(t *testing.T) {
+	tests := []struct {
+		node     Node
+		expected string
+	}{
+		{Div().Class("btn").AddClass("btn-primary", "lg"), `<div class="btn btn-primary lg"></div>`},
+		{Div().Class("btn active").AddClass("active", "btn", "x y"), `<div class="btn active x y"></div>`},
+		{Div().AddClass("a").AddClassIf(false, "b").AddClassIf(true, "c"), `<div class="a c"></div>`},
+		{Div().AddClass("a").Class("b"), `<div class="b"></div>`},
+		{Div().AddClass("", " "), `<div></div>`},
+	}
+
+	for _, test := range tests {
+		got, err := RenderString(test.node)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if got != test.expected {
+			t.Errorf("expected: \"%s\"; got: \"%s\"", test.expected, got)
+		}
+	}
+}
+
+func TestStyleMap(t *testing.T) {
+	tests := []struct {
+		node     Node
+		expected string
+	}{
+		{Div().StyleMap(map[string]string{"color": "red", "--accent": "#f00", "margin": "0 auto"}), `<div style="--accent:#f00;color:red;margin:0 auto;"></div>`},
+		{Div().StyleMap(map[string]string{"font-family": `"Inter"`}), `<div style="font-family:&#34;Inter&#34;;"></div>`},
+		{Div().StyleMap(map[string]string{}), `<div></div>`},
+		{Div().StyleMap(map[string]string{"color": ""}), `<div></div>`},
+		{Div().StyleMapIf(false, map[string]string{"color": "red"}), `<div></div>`},
+	}
+
+	for _, test := range tests {
+		got, err := RenderString(test.node)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if got != test.expected {
+			t.Errorf("expected: \"%s\"; got: \"%s\"", test.expected, got)
+		}
+	}
+}
+
+func TestMapOf(t *testing.T) {
+	terms := map[string]string{
+		"HTML": "HyperText Markup Language",
+		"CSS":  "Cascading Style Sheets",
+	}
+
+	got, err := RenderString(Dl(SortedMapOf(terms, func(term, definition string) Node {
+		return Group(Dt(Text(term)), Dd(Text(definition)))
+	})))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if expected := `<dl><dt>CSS</dt><dd>Cascading Style Sheets</dd><dt>HTML</dt><dd>HyperText Markup Language</dd></dl>`; got != expected {
+		t.Errorf("expected: \"%s\"; got: \"%s\"", expected, got)
+	}
+
+	got, err = RenderString(MapOf(map[int]bool{1: true}, func(k int, v bool) Node {
+		return Textf("%d=%t", k, v)
+	}))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if expected := "1=true"; got != expected {
+		t.Errorf("expected: \"%s\"; got: \"%s\"", expected, got)
+	}
+}
+
+// Card is an example of a reusable component defined as a struct
+type Card struct {
+	Title string
+	Body  string
+}
+
+func (c Card) Render(w io.Writer) error {
+	return Div(
+		H2(Text(c.Title)),
+		P(Text(c.Body)),
+	).Class("card").Render(w)
+}
+
+func TestComponent(t *testing.T) {
+	cards := []Card{{Title: "One", Body: "First"}, {Title: "Two", Body: "Second"}}
+
+	got, err := RenderString(Section(
+		C(cards[0]),
+		Map(cards[1:], func(c Card) Node { return C(c) }),
+		C(nil),
+	))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if expected := `<section><div class="card"><h2>One</h2><p>First</p></div><div class="card"><h2>Two</h2><p>Second</p></div></section>`; got != expected {
+		t.Errorf("expected: \"%s\"; got: \"%s\"", expected, got)
+	}
+
+	sb := &strings.Builder{}
+	if err := Render(Card{Title: "Solo"}, sb); err != nil {
+		t.Fatal(err)
+	}
+	if expected := `<div class="card"><h2>Solo</h2><p></p></div>`; sb.String() != expected {
+		t.Errorf("expected: \"%s\"; got: \"%s\"", expected, sb.String())
+	}
+}
+
+func TestEl(t *testing.T) {
+	tests := []struct {
+		node     Node
+		expected string
+	}{
+		{El("search", Input().Type("search")), `<search><input type="search"/></search>`},
+		{El("my-widget").Class("w").Attribute("size", "3"), `<my-widget class="w" size="3"></my-widget>`},
+		{El("marquee", Text("hi")), `<marquee>hi</marquee>`},
+		{VoidEl("x-spacer").Attribute("height", "4"), `<x-spacer height="4"/>`},
+	}
+
+	for _, test := range tests {
+		got, err := RenderString(test.node)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if got != test.expected {
+			t.Errorf("expected: \"%s\"; got: \"%s\"", test.expected, got)
+		}
+	}
+
+	for _, name := range []string{"", "1div", "div onclick=x", "a>", "x/y", "<b"} {
+		func() {
+			defer func() {
+				if recover() == nil {
+					t.Errorf("expected El(%q) to panic", name)
+				}
+			}()
+			El(name)
+		}()
+	}
+}
+
+func TestCustomAttributeNames(t *testing.T) {
+	tests := []struct {
+		node     Node
+		expected string
+	}{
+		{El("my-counter").Attribute("count", "3"), `<my-counter count="3"></my-counter>`},
+		{Div().Attribute("x-data", "{ open: false }").Attribute("@click", "open = !open"), `<div @click="open = !open" x-data="{ open: false }"></div>`},
+		{Input().Attribute("v-bind:value", "name").Attribute(":class", "c"), `<input :class="c" v-bind:value="name"/>`},
+		{Div().Attribute(`x onload=alert(1) y`, "1"), `<div></div>`},
+		{Div().Attribute(`a"b`, "1").Attribute("a>b", "1").Attribute("a/b", "1").Attribute("", "1"), `<div></div>`},
+		{Div().AttributeIf(true, "a=b", "1"), `<div></div>`},
+	}
+
+	for _, test := range tests {
+		got, err := RenderString(test.node)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if got != test.expected {
+			t.Errorf("expected: \"%s\"; got: \"%s\"", test.expected, got)
+		}
+	}
+}
+
+func TestMathML(t *testing.T) {
+	fraction := Math(
+		Mfrac(
+			Mrow(Mi(Text("a")), Mo(Text("+")), Mn(Text("1"))),
+			Msqrt(Msup(Mi(Text("x")), Mn(Text("2")))),
+		),
+		Msub(Mi(Text("y")), Mn(Text("0"))),
+		Mtext(Text(" done")),
+	).Display("block")
+
+	got, err := RenderString(fraction)
+	if err != nil {
+		t.Fatal(err)
+	}
+	const expected = `<math display="block"><mfrac><mrow><mi>a</mi><mo>+</mo><mn>1</mn></mrow><msqrt><msup><mi>x</mi><mn>2</mn></msup></msqrt></mfrac><msub><mi>y</mi><mn>0</mn></msub><mtext> done</mtext></math>`
+	if got != expected {
+		t.Errorf("expected: \"%s\"; got: \"%s\"", expected, got)
+	}
+}
+
+func TestSVGGradientsAndText(t *testing.T) {
+	image := SVG(
+		Defs(
+			LinearGradient(
+				Stop().Offset("0%").StopColor("#fff"),
+				Stop().Offset("100%").StopColor("#000").StopOpacity("0.5"),
+			).GradientUnits("userSpaceOnUse").X1("0").X2("1").ID("fade"),
+			ClipPath(Circle().R("5")).ID("clip"),
+			Symbol().ViewBox("0 0 10 10").ID("icon"),
+		),
+		Text_(Text("Hello "), Tspan(Text("world")).Dx("2")).X("10").Y("20").TextAnchor("middle"),
+	).ViewBox("0 0 100 100")
+
+	got, err := RenderString(image)
+	if err != nil {
+		t.Fatal(err)
+	}
+	const expected = `<svg viewBox="0 0 100 100"><defs><linearGradient gradientUnits="userSpaceOnUse" id="fade" x1="0" x2="1"><stop offset="0%" stop-color="#fff"></stop><stop offset="100%" stop-color="#000" stop-opacity="0.5"></stop></linearGradient><clipPath id="clip"><circle r="5"></circle></clipPath><symbol id="icon" viewBox="0 0 10 10"></symbol></defs><text text-anchor="middle" x="10" y="20">Hello <tspan dx="2">world</tspan></text></svg>`
+	if got != expected {
+		t.Errorf("expected: \"%s\"; got: \"%s\"", expected, got)
+	}
+}
+
+func TestSVGPresentationAttributes(t *testing.T) {
+	tests := []struct {
+		node     Node
+		expected string
+	}{
+		{Path().D("M0 0L10 10").Stroke("red").StrokeWidth("2").StrokeLinecap("round").Fill("none"), `<path d="M0 0L10 10" fill="none" stroke="red" stroke-linecap="round" stroke-width="2"></path>`},
+		{Rect().X("1").Fill("#333").FillOpacity("0.5").Rx("2"), `<rect fill="#333" fill-opacity="0.5" rx="2" x="1"></rect>`},
+		{Polygon().Points("0,0 1,1").StrokeLinejoin("bevel").StrokeDasharray("4 2"), `<polygon points="0,0 1,1" stroke-dasharray="4 2" stroke-linejoin="bevel"></polygon>`},
+		{G(Circle().Cx("5").Fill("blue")).Transform("rotate(45)").Opacity("0.8"), `<g opacity="0.8" transform="rotate(45)"><circle cx="5" fill="blue"></circle></g>`},
+		{Ellipse().FillIf(false, "red").StrokeIf(true, "black"), `<ellipse stroke="black"></ellipse>`},
+		{Line().X1("0").StrokeWidth("3"), `<line stroke-width="3" x1="0"></line>`},
+	}
+
+	for _, test := range tests {
+		got, err := RenderString(test.node)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if got != test.expected {
+			t.Errorf("expected: \"%s\"; got: \"%s\"", test.expected, got)
+		}
+	}
+}
+
+func TestUnless(t *testing.T) {
+	called := false
+	lazy := func() Node {
+		called = true
+		return Text("lazy")
+	}
+
+	tests := []struct {
+		node     Node
+		expected string
+	}{
+		{If(true, Text("yes")), `yes`},
+		{If(false, Text("yes")), ``},
+		{Unless(true, Text("yes")), ``},
+		{Unless(false, Text("yes")), `yes`},
+		{Div(Unless(false, Span()), Unless(true, P())), `<div><span></span></div>`},
+		{IfFunc(true, func() Node { return Text("yes") }), `yes`},
+		{UnlessFunc(false, func() Node { return Text("yes") }), `yes`},
+		{UnlessFunc(true, lazy), ``},
+		{UnlessFunc(false, nil), ``},
+	}
+
+	for _, test := range tests {
+		got, err := RenderString(test.node)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if got != test.expected {
+			t.Errorf("expected: \"%s\"; got: \"%s\"", test.expected, got)
+		}
+	}
+	if called {
+		t.Error("expected UnlessFunc not to evaluate its content when the condition is true")
+	}
+}
+
+func TestRepeat(t *testing.T) {
+	tests := []struct {
+		node     Node
+		expected string
+	}{
+		{
+			Ul(Repeat(5, func(i int) Node { return Li().Class("skeleton") })),
+			`<ul><li class="skeleton"></li><li class="skeleton"></li><li class="skeleton"></li><li class="skeleton"></li><li class="skeleton"></li></ul>`,
+		},
+		{
+			Group(Text("<"), Repeat(3, func(i int) Node { return Text(strconv.Itoa(i)) }), Text(">")),
+			`&lt;012&gt;`,
+		},
+		{Repeat(0, func(i int) Node { return P() }), ``},
+		{Repeat(-2, func(i int) Node { return P() }), ``},
+	}
+
+	for _, test := range tests {
+		got, err := RenderString(test.node)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if got != test.expected {
+			t.Errorf("expected: \"%s\"; got: \"%s\"", test.expected, got)
+		}
+	}
+}
+
+func TestCoalesce(t *testing.T) {
+	var missing Node
+	tests := []struct {
+		node     Node
+		expected string
+	}{
+		{Coalesce(missing, Img().SafeSrc("/default.png"), Img().SafeSrc("/other.png")), `<img src="/default.png"/>`},
+		{Coalesce(Text("first"), Text("second")), `first`},
+		{Div(Coalesce(nil, nil)), `<div></div>`},
+		{Coalesce(), ``},
+	}
+
+	for _, test := range tests {
+		got, err := RenderString(test.node)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if got != test.expected {
+			t.Errorf("expected: \"%s\"; got: \"%s\"", test.expected, got)
+		}
+	}
+}
+
+func TestInputAttributes(t *testing.T) {
+	tests := []struct {
+		node     Node
+		expected string
+	}{
+		{
+			Input().Type("number").Name("qty").Value("1").Step("0.5").Min("0"),
+			`<input min="0" name="qty" step="0.5" type="number" value="1"/>`,
+		},
+		{
+			Input().Type("file").Accept("image/png,image/jpeg").Multiple("multiple"),
+			`<input accept="image/png,image/jpeg" multiple="multiple" type="file"/>`,
+		},
+		{
+			Input().List("browsers").Autocomplete("off").Autofocus("autofocus").Disabled("disabled"),
+			`<input autocomplete="off" autofocus="autofocus" disabled="disabled" list="browsers"/>`,
+		},
+		{
+			Input().ValueIf(false, "x").StepIf(false, "1").ListIf(false, "l").AcceptIf(false, "a").
+				MultipleIf(false, "multiple").AutocompleteIf(false, "on").AutofocusIf(false, "autofocus").
+				DisabledIf(true, "disabled"),
+			`<input disabled="disabled"/>`,
+		},
+		{Input().Type("text").Name("q").Value(""), `<input name="q" type="text" value=""/>`},
+	}
+
+	for _, test := range tests {
+		got, err := RenderString(test.node)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if got != test.expected {
+			t.Errorf("expected: \"%s\"; got: \"%s\"", test.expected, got)
+		}
+	}
+}
+
+func TestProgressMeter(t *testing.T) {
+	tests := []struct {
+		node     Node
+		expected string
+	}{
+		{Progress().Value("70").Max("100"), `<progress max="100" value="70"></progress>`},
+		{Progress().ValueIf(false, "70").MaxIf(true, "100"), `<progress max="100"></progress>`},
+		{
+			Meter(Text("0.6")).Value("0.6").Min("0").Max("1").Low("0.2").High("0.8"),
+			`<meter high="0.8" low="0.2" max="1" min="0" value="0.6">0.6</meter>`,
+		},
+		{Meter().ValueIf(true, "3").MinIf(false, "0").MaxIf(false, "5"), `<meter value="3"></meter>`},
+	}
+
+	for _, test := range tests {
+		got, err := RenderString(test.node)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if got != test.expected {
+			t.Errorf("expected: \"%s\"; got: \"%s\"", test.expected, got)
+		}
+	}
+}
+
+func TestSelectOptions(t *testing.T) {
+	got, err := RenderString(Select(
+		Option(Text("Choose…")).Value("").Disabled("disabled").Selected("selected"),
+		Optgroup(
+			Option(Text("USA")).Value("us"),
+			Option(Text("Canada")).Value("ca").Label("CA"),
+		).Label("North America"),
+		Optgroup(
+			Option(Text("Atlantis")).Value("at").DisabledIf(false, "disabled"),
+		).Label("Lost").Disabled("disabled"),
+	).Attribute("name", "country"))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	expected := `<select name="country">` +
+		`<option disabled="disabled" selected="selected" value="">Choose…</option>` +
+		`<optgroup label="North America"><option value="us">USA</option><option label="CA" value="ca">Canada</option></optgroup>` +
+		`<optgroup disabled="disabled" label="Lost"><option value="at">Atlantis</option></optgroup>` +
+		`</select>`
+	if got != expected {
+		t.Errorf("expected: \"%s\"; got: \"%s\"", expected, got)
+	}
+}
+
+func TestImgAttributes(t *testing.T) {
+	tests := []struct {
+		node     Node
+		expected string
+	}{
+		{
+			Img().Src("/hero.jpg").Alt("A hero").Width("1200").Height("630").
+				Loading("lazy").Fetchpriority("high").Referrerpolicy("no-referrer"),
+			`<img alt="A hero" fetchpriority="high" height="630" loading="lazy" referrerpolicy="no-referrer" src="/hero.jpg" width="1200"/>`,
+		},
+		{
+			Img().SrcIf(true, "/a.png").AltIf(true, "a").WidthIf(false, "1").HeightIf(false, "1").
+				LoadingIf(false, "lazy").FetchpriorityIf(false, "low").ReferrerpolicyIf(false, "origin"),
+			`<img alt="a" src="/a.png"/>`,
+		},
+	}
+
+	for _, test := range tests {
+		got, err := RenderString(test.node)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if got != test.expected {
+			t.Errorf("expected: \"%s\"; got: \"%s\"", test.expected, got)
+		}
+	}
+}
+
+func TestVideoAttributes(t *testing.T) {
+	tests := []struct {
+		node     Node
+		expected string
+	}{
+		{
+			Video().Src("/clip.mp4").Autoplay("autoplay").Loop("loop").Muted("muted").Playsinline("playsinline"),
+			`<video autoplay="autoplay" loop="loop" muted="muted" playsinline="playsinline" src="/clip.mp4"></video>`,
+		},
+		{
+			Video().Width("640").Height("360").Controls("controls").Preload("metadata").Poster("/poster.jpg"),
+			`<video controls="controls" height="360" poster="/poster.jpg" preload="metadata" width="640"></video>`,
+		},
+		{
+			Video().SrcIf(false, "/a.mp4").ControlsIf(true, "controls").AutoplayIf(false, "autoplay").
+				LoopIf(false, "loop").MutedIf(false, "muted").PlaysinlineIf(false, "playsinline"),
+			`<video controls="controls"></video>`,
+		},
+	}
+
+	for _, test := range tests {
+		got, err := RenderString(test.node)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if got != test.expected {
+			t.Errorf("expected: \"%s\"; got: \"%s\"", test.expected, got)
+		}
+	}
+}
+
+func TestPictureSources(t *testing.T) {
+	got, err := RenderString(Picture(
+		Source().Media("(min-width: 1024px)").Srcset("/hero-wide.avif 1x, /hero-wide@2x.avif 2x").Type("image/avif"),
+		Source().Media("(min-width: 640px)").Srcset("/hero.webp").Sizes("100vw").Width("800").Height("400"),
+		Img().Src("/hero.jpg").Alt("Hero"),
+	))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	expected := `<picture>` +
+		`<source media="(min-width: 1024px)" srcset="/hero-wide.avif 1x, /hero-wide@2x.avif 2x" type="image/avif"/>` +
+		`<source height="400" media="(min-width: 640px)" sizes="100vw" srcset="/hero.webp" width="800"/>` +
+		`<img alt="Hero" src="/hero.jpg"/>` +
+		`</picture>`
+	if got != expected {
+		t.Errorf("expected: \"%s\"; got: \"%s\"", expected, got)
+	}
+
+	got, err = RenderString(Video(Source().Src("/clip.webm").TypeIf(true, "video/webm").MediaIf(false, "print")))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if expected := `<video><source src="/clip.webm" type="video/webm"/></video>`; got != expected {
+		t.Errorf("expected: \"%s\"; got: \"%s\"", expected, got)
+	}
+}
+
+func TestOn(t *testing.T) {
+	tests := []struct {
+		node     Node
+		expected string
+	}{
+		{Button(Text("Go")).On("click", "go()"), `<button onclick="go()">Go</button>`},
+		{Input().On("change", `say("hi")`).On("input", "x = 1 < 2"), `<input onchange="say(&#34;hi&#34;)" oninput="x = 1 &lt; 2"/>`},
+		{Form().OnIf(false, "submit", "return false").OnIf(true, "reset", "clear()"), `<form onreset="clear()"></form>`},
+		{Div().On("click\" onload=\"evil()", "x"), `<div></div>`},
+	}
+
+	for _, test := range tests {
+		got, err := RenderString(test.node)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if got != test.expected {
+			t.Errorf("expected: \"%s\"; got: \"%s\"", test.expected, got)
+		}
+	}
+}
+
+func TestRemoveGetHasAttribute(t *testing.T) {
+	div := Div().Class("box").ID("main").AttributeIf(true, "data-empty", "")
+
+	if value, ok := div.GetAttribute("class"); !ok || value != "box" {
+		t.Errorf("expected class \"box\"; got: %q, %v", value, ok)
+	}
+	if _, ok := div.GetAttribute("title"); ok {
+		t.Error("expected title to be unset")
+	}
+	if !div.HasAttribute("data-empty") || div.HasAttribute("title") {
+		t.Error("expected HasAttribute to report set attributes, including empty ones")
+	}
+
+	div.RemoveAttribute("id").RemoveAttribute("missing")
+	if div.HasAttribute("id") {
+		t.Error("expected id to be removed")
+	}
+	if got, expected := MustRenderString(div), `<div class="box" data-empty=""></div>`; got != expected {
+		t.Errorf("expected: \"%s\"; got: \"%s\"", expected, got)
+	}
+}
+
+func TestAttrs(t *testing.T) {
+	tests := []struct {
+		node     Node
+		expected string
+	}{
+		{
+			Button().Class("btn").Style("color:red").Attribute("type", "button").
+				Attrs(Attribute{"class": "btn-primary btn", "style": "margin:0", "type": "submit", "data-x": "1"}),
+			`<button class="btn btn-primary" data-x="1" style="color:red;margin:0" type="submit"></button>`,
+		},
+		{Div().Attrs(Attribute{"hidden": "", "bad name": "x"}), `<div hidden=""></div>`},
+		{Div().Style("color:red;").Attrs(Attribute{"style": ""}), `<div style="color:red;"></div>`},
+		{
+			A().Href("/").Class("link").MergeAttrs(Span().Class("active").Aria("current", "page")),
+			`<a aria-current="page" class="link active" href="/"></a>`,
+		},
+		{Div().ID("x").MergeAttrs(nil), `<div id="x"></div>`},
+	}
+
+	for _, test := range tests {
+		got, err := RenderString(test.node)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if got != test.expected {
+			t.Errorf("expected: \"%s\"; got: \"%s\"", test.expected, got)
+		}
+	}
+}
+
+func TestWrap(t *testing.T) {
+	card := func(url string) Node {
+		return Wrap(url != "", A().Href(url).Tag, H3(Text("Title")), P(Text("Body")))
+	}
+
+	tests := []struct {
+		node     Node
+		expected string
+	}{
+		{card("/post"), `<a href="/post"><h3>Title</h3><p>Body</p></a>`},
+		{card(""), `<h3>Title</h3><p>Body</p>`},
+		{Wrap(true, nil, Text("bare")), `bare`},
+		{Div(Wrap(true, Span().Class("x"), Text("a"))), `<div><span class="x">a</span></div>`},
+	}
+
+	for _, test := range tests {
+		got, err := RenderString(test.node)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if got != test.expected {
+			t.Errorf("expected: \"%s\"; got: \"%s\"", test.expected, got)
+		}
+	}
+}
+
+func TestTextareaValue(t *testing.T) {
+	tests := []struct {
+		node     Node
+		expected string
+	}{
+		{Textarea(Raw("<b>")).Value("a < b"), `<textarea>a &lt; b</textarea>`},
+		{Textarea().Value("\nfirst line"), "<textarea>\n\nfirst line</textarea>"},
+		{Textarea().Rows("3").Value(""), `<textarea rows="3"></textarea>`},
+	}
+
+	for _, test := range tests {
+		got, err := RenderString(test.node)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if got != test.expected {
+			t.Errorf("expected: %q; got: %q", test.expected, got)
+		}
+	}
+
+	nodes, err := Parse(MustRenderString(Textarea().Value("\n<script>x</script>")))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got, expected := MustRenderString(Group(nodes...)), "<textarea>\n&lt;script&gt;x&lt;/script&gt;</textarea>"; got != expected {
+		t.Errorf("expected the value to round-trip: %q; got: %q", expected, got)
+	}
+}
+
+func TestDoctype(t *testing.T) {
+	xhtml := `<!DOCTYPE html PUBLIC "-//W3C//DTD XHTML 1.0 Strict//EN" "http://www.w3.org/TR/xhtml1/DTD/xhtml1-strict.dtd">`
+	tests := []struct {
+		node     Node
+		expected string
+	}{
+		{Document(HTML()), `<!DOCTYPE html><html></html>`},
+		{Document(HTML()).Doctype(xhtml), xhtml + `<html></html>`},
+		{Document(HTML()).Doctype(""), `<html></html>`},
+		{Fragment(Li(Text("a")), Li(Text("b"))), `<li>a</li><li>b</li>`},
+	}
+
+	for _, test := range tests {
+		got, err := RenderString(test.node)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if got != test.expected {
+			t.Errorf("expected: \"%s\"; got: \"%s\"", test.expected, got)
+		}
+	}
+
+	nodes, err := Parse(xhtml + `<html><body></body></html>`)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got := MustRenderString(Group(nodes...)); !strings.HasPrefix(got, xhtml) {
+		t.Errorf("expected the parsed doctype to be kept; got: \"%s\"", got)
+	}
+}
+
+func TestClasses(t *testing.T) {
+	tests := []struct {
+		got      string
+		expected string
+	}{
+		{Classes("btn", "", "btn  active", " "), "btn active"},
+		{Classes("b a", "a c"), "b a c"},
+		{Classes(), ""},
+		{MustRenderString(Div().Class("card", "card\tshadow", "")), `<div class="card shadow"></div>`},
+		{MustRenderString(Div().Class("", " ")), `<div></div>`},
+	}
+
+	for _, test := range tests {
+		if test.got != test.expected {
+			t.Errorf("expected: \"%s\"; got: \"%s\"", test.expected, test.got)
+		}
+	}
+}
+
+func TestLinkResourceHints(t *testing.T) {
+	tests := []struct {
+		node     Node
+		expected string
+	}{
+		{
+			Link().Rel("preload").Href("/app.css").As("style").Crossorigin("anonymous").Fetchpriority("high"),
+			`<link as="style" crossorigin="anonymous" fetchpriority="high" href="/app.css" rel="preload"/>`,
+		},
+		{
+			Link().Rel("preload").As("image").Imagesrcset("/a.png 1x, /a@2x.png 2x").Imagesizes("100vw").
+				Media("(min-width: 600px)").Type("image/png"),
+			`<link as="image" imagesizes="100vw" imagesrcset="/a.png 1x, /a@2x.png 2x" media="(min-width: 600px)" rel="preload" type="image/png"/>`,
+		},
+		{
+			Link().Rel("icon").Href("/icon.png").Sizes("32x32").AsIf(false, "image").CrossoriginIf(true, "use-credentials"),
+			`<link crossorigin="use-credentials" href="/icon.png" rel="icon" sizes="32x32"/>`,
+		},
+	}
+
+	for _, test := range tests {
+		got, err := RenderString(test.node)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if got != test.expected {
+			t.Errorf("expected: \"%s\"; got: \"%s\"", test.expected, got)
+		}
+	}
+}
+
+func TestBooleanAttributes(t *testing.T) {
+	tests := []struct {
+		node     Node
+		expected string
+	}{
+		{Details(Summary(Text("More")), P(Text("Body"))).Open(), `<details open><summary>More</summary><p>Body</p></details>`},
+		{Details().OpenIf(false), `<details></details>`},
+		{Dialog(Text("Hi")).Open().Returnvalue("cancel"), `<dialog open returnvalue="cancel">Hi</dialog>`},
+		{Dialog().OpenIf(true).ReturnvalueIf(false, "x"), `<dialog open></dialog>`},
+		{Input().BooleanAttribute("required").BooleanAttributeIf(false, "disabled"), `<input required/>`},
+		{Div().BooleanAttribute("hidden").Attribute("hidden", "until-found"), `<div hidden="until-found"></div>`},
+		{Div().BooleanAttribute("hidden").AttributeIf(true, "hidden", ""), `<div hidden=""></div>`},
+		{Div().BooleanAttribute("hidden").RemoveAttribute("hidden"), `<div></div>`},
+		{Div().BooleanAttribute("bad name"), `<div></div>`},
+	}
+
+	for _, test := range tests {
+		if got := MustRenderString(test.node); got != test.expected {
+			t.Errorf("expected: \"%s\"; got: \"%s\"", test.expected, got)
+		}
+	}
+
+	if got, ok := Details().Open().GetAttribute("open"); !ok || got != "" {
+		t.Errorf("expected open to be set to an empty value; got: %q, %v", got, ok)
+	}
+	if got := MustRenderString(Div().MergeAttrs(Details().Open().Tag)); got != `<div open></div>` {
+		t.Errorf("expected MergeAttrs to keep boolean attributes; got: \"%s\"", got)
+	}
+	if got := MustRenderString(Clone(Details().Open())); got != `<details open></details>` {
+		t.Errorf("expected Clone to keep boolean attributes; got: \"%s\"", got)
+	}
+}
+
+func TestSVGImage(t *testing.T) {
+	got := MustRenderString(SVG(
+		Image().Href("/photo.png").X("10").Y("20").Width("100").Height("50").OpacityIf(true, "0.5"),
+		Image().XlinkHref("/legacy.png").WidthIf(false, "1"),
+	))
+	expected := `<svg><image height="50" href="/photo.png" opacity="0.5" width="100" x="10" y="20"></image>` +
+		`<image xlink:href="/legacy.png"></image></svg>`
+	if got != expected {
+		t.Errorf("expected: \"%s\"; got: \"%s\"", expected, got)
+	}
+}
+
+func TestSVGShapeChaining(t *testing.T) {
+	tests := []struct {
+		node     Node
+		expected string
+	}{
+		{Rect().Width("100").Height("50").Rx("8").Ry("8").Fill("#0af"), `<rect fill="#0af" height="50" rx="8" ry="8" width="100"></rect>`},
+		{Rect().WidthIf(false, "1").HeightIf(true, "2").Class("bar").Fill("red"), `<rect class="bar" fill="red" height="2"></rect>`},
+		{Path().ID("arrow").Class("icon", "icon-sm").D("M0 0").Stroke("black"), `<path class="icon icon-sm" d="M0 0" id="arrow" stroke="black"></path>`},
+		{Polygon().ClassIf(true, "shape").IDIf(false, "x").Points("0,0 1,1").Fill("green"), `<polygon class="shape" fill="green" points="0,0 1,1"></polygon>`},
+		{Polyline().ID("trend").Points("0,0 5,5").Stroke("blue"), `<polyline id="trend" points="0,0 5,5" stroke="blue"></polyline>`},
+		{G().Class("layer").Transform("scale(2)"), `<g class="layer" transform="scale(2)"></g>`},
+	}
+
+	for _, test := range tests {
+		if got := MustRenderString(test.node); got != test.expected {
+			t.Errorf("expected: \"%s\"; got: \"%s\"", test.expected, got)
+		}
+	}
+}
+
+func TestMicrodata(t *testing.T) {
+	product := Div(
+		Span(Text("Widget")).Itemprop("name"),
+		Span(Text("9.99")).Itemprop("price"),
+	).Itemscope().Itemtype("https://schema.org/Product").Itemid("urn:sku:42&x")
+	expected := `<div itemid="urn:sku:42&amp;x" itemscope itemtype="https://schema.org/Product">` +
+		`<span itemprop="name">Widget</span><span itemprop="price">9.99</span></div>`
+
+	if got := MustRenderString(product); got != expected {
+		t.Errorf("expected: \"%s\"; got: \"%s\"", expected, got)
+	}
+
+	conditional := Div().ItemscopeIf(false).ItemtypeIf(false, "x").ItempropIf(true, "review").ItemidIf(false, "y")
+	if got, expected := MustRenderString(conditional), `<div itemprop="review"></div>`; got != expected {
+		t.Errorf("expected: \"%s\"; got: \"%s\"", expected, got)
+	}
+}
+
+func TestNodes(t *testing.T) {
+	var items []Node
+	for _, s := range []string{"a", "", "b"} {
+		if s == "" {
+			items = append(items, nil)
+			continue
+		}
+		items = append(items, Li(Text(s)))
+	}
+
+	if got, expected := MustRenderString(Ul(Nodes(items))), "<ul><li>a</li><li>b</li></ul>"; got != expected {
+		t.Errorf("expected: \"%s\"; got: \"%s\"", expected, got)
+	}
+	if got := MustRenderString(Nodes(nil)); got != "" {
+		t.Errorf("expected an empty slice to render nothing; got: \"%s\"", got)
+	}
+}
+
+func TestAppendPrepend(t *testing.T) {
+	card := Div(P(Text("Body"))).Class("card")
+	card.Append(Footer(Text("Footer")), nil)
+	card.Prepend(Header(Text("Header")))
+	card.Append()
+
+	expected := `<div class="card"><header>Header</header><p>Body</p><footer>Footer</footer></div>`
+	if got := MustRenderString(card); got != expected {
+		t.Errorf("expected: \"%s\"; got: \"%s\"", expected, got)
+	}
+	if got := len(card.ChildNodes()); got != 4 {
+		t.Errorf("expected 4 children including the nil one; got: %d", got)
+	}
+
+	items := make([]Node, 0, 4)
+	items = append(items, P(Text("body")))
+	a, b := Div(items...), Div(items...)
+	a.Append(Text("A"))
+	b.Append(Text("B"))
+	if got, expected := MustRenderString(a), "<div><p>body</p>A</div>"; got != expected {
+		t.Errorf("expected: \"%s\"; got: \"%s\"", expected, got)
+	}
+	if got, expected := MustRenderString(b), "<div><p>body</p>B</div>"; got != expected {
+		t.Errorf("expected: \"%s\"; got: \"%s\"", expected, got)
+	}
+}
+
+func TestOpt(t *testing.T) {
+	header, footer := Header(Text("Top")), Footer(Text("Bottom"))
+	tests := []struct {
+		node     Node
+		expected string
+	}{
+		{Div().Children(header, Opt(false, footer)), "<div><header>Top</header></div>"},
+		{Div().Children(header, Opt(true, footer)), "<div><header>Top</header><footer>Bottom</footer></div>"},
+	}
+
+	for _, test := range tests {
+		if got := MustRenderString(test.node); got != test.expected {
+			t.Errorf("expected: \"%s\"; got: \"%s\"", test.expected, got)
+		}
+	}
+	if Opt(false, footer) != nil {
+		t.Error("expected Opt to return nil when the condition is false")
+	}
+}
+
+func TestBlankLinks(t *testing.T) {
+	tests := []struct {
+		node     Node
+		expected string
+	}{
+		{A(Text("Docs")).Href("/docs").Blank(), `<a href="/docs" rel="noopener noreferrer" target="_blank">Docs</a>`},
+		{A().Rel("nofollow noopener").Blank(), `<a rel="nofollow noopener noreferrer" target="_blank"></a>`},
+		{ExternalLink("https://go.dev", Text("Go")), `<a href="https://go.dev" rel="noopener noreferrer" target="_blank">Go</a>`},
+		{ExternalLink("https://go.dev").Target("_self"), `<a href="https://go.dev" rel="noopener noreferrer" target="_self"></a>`},
+	}
+
+	for _, test := range tests {
+		if got := MustRenderString(test.node); got != test.expected {
+			t.Errorf("expected: \"%s\"; got: \"%s\"", test.expected, got)
+		}
+	}
+}
+
+func TestMergeClass(t *testing.T) {
+	tests := []struct {
+		existing, incoming, expected string
+	}{
+		{"card", "mt-4", "card mt-4"},
+		{"card shadow", " mt-4 card", "card shadow mt-4"},
+		{"", "  ", ""},
+		{"  a  b ", "", "a b"},
+	}
+	for _, test := range tests {
+		if got := MergeClass(test.existing, test.incoming); got != test.expected {
+			t.Errorf("MergeClass(%q, %q): expected: \"%s\"; got: \"%s\"", test.existing, test.incoming, test.expected, got)
+		}
+	}
+
+	// Attrs passes classes through with the same rule
+	card := Div().Class("card").Attrs(Attribute{"class": "mt-4 card"})
+	if got, expected := MustRenderString(card), `<div class="card mt-4"></div>`; got != expected {
+		t.Errorf("expected: \"%s\"; got: \"%s\"", expected, got)
+	}
+}
+
+func TestSpaceNewline(t *testing.T) {
+	node := P(A(Text("a")).Href("/a"), Space(), A(Text("b")).Href("/b"), Newline())
+	if got, expected := MustRenderString(node), "<p><a href=\"/a\">a</a> <a href=\"/b\">b</a>\n</p>"; got != expected {
+		t.Errorf("expected: %q; got: %q", expected, got)
+	}
+
+	sb := &strings.Builder{}
+	if err := RenderMinified(Span(A(Text("a")), Space(), A(Text("b"))), sb); err != nil {
+		t.Fatal(err)
+	}
+	if got, expected := sb.String(), "<span><a>a</a> <a>b</a></span>"; got != expected {
+		t.Errorf("expected: %q; got: %q", expected, got)
+	}
+}
@@ -0,0 +1,285 @@
+/**
+ * Copyright 2025 Alexis Bouchez <alexbcz@proton.me>
+ *
+ * This file is part of libhtml.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ */
+
+// Package htmltest provides helpers for testing code that renders HTML.
+package htmltest
+
+import (
+	"fmt"
+	"html"
+	"slices"
+	"strings"
+	"testing"
+
+	nethtml "golang.org/x/net/html"
+	"golang.org/x/net/html/atom"
+)
+
+// AssertEqualHTML reports an error when got and want aren't the same HTML once
+// normalized with Normalize, printing a Diff of both normalized forms on mismatch
+func AssertEqualHTML(t testing.TB, got, want string) {
+	t.Helper()
+
+	normalizedGot, err := Normalize(got)
+	if err != nil {
+		t.Fatalf("normalizing got: %v", err)
+	}
+	normalizedWant, err := Normalize(want)
+	if err != nil {
+		t.Fatalf("normalizing want: %v", err)
+	}
+
+	if normalizedGot != normalizedWant {
+		t.Errorf("HTML mismatch (-want +got):\n%s", diffLines(normalizedGot, normalizedWant))
+	}
+}
+
+// Diff returns a line diff of got and want once normalized with Normalize, or
+// an empty string when they are the same HTML. Lines only in want are
+// prefixed with "- ", lines only in got with "+ " and shared lines with
+// two spaces. When a changed tag keeps its name, a "~ " line follows listing
+// the attributes removed and added so the difference stands out. Input that
+// fails to normalize is compared as is.
+func Diff(got, want string) string {
+	if normalized, err := Normalize(got); err == nil {
+		got = normalized
+	}
+	if normalized, err := Normalize(want); err == nil {
+		want = normalized
+	}
+	if got == want {
+		return ""
+	}
+	return diffLines(got, want)
+}
+
+// diffLines returns the line diff of two normalized strings, computed from
+// their longest common subsequence of lines
+func diffLines(got, want string) string {
+	a, b := strings.Split(want, "\n"), strings.Split(got, "\n")
+
+	// lcs[i][j] is the length of the longest common subsequence of a[i:] and b[j:]
+	lcs := make([][]int, len(a)+1)
+	for i := range lcs {
+		lcs[i] = make([]int, len(b)+1)
+	}
+	for i := len(a) - 1; i >= 0; i-- {
+		for j := len(b) - 1; j >= 0; j-- {
+			if a[i] == b[j] {
+				lcs[i][j] = lcs[i+1][j+1] + 1
+			} else {
+				lcs[i][j] = max(lcs[i+1][j], lcs[i][j+1])
+			}
+		}
+	}
+
+	sb := &strings.Builder{}
+	var removed, added []string
+	flush := func() {
+		for _, line := range removed {
+			sb.WriteString("- " + line + "\n")
+		}
+		for _, line := range added {
+			sb.WriteString("+ " + line + "\n")
+		}
+		for k := 0; k < min(len(removed), len(added)); k++ {
+			if changes := attrChanges(removed[k], added[k]); changes != "" {
+				sb.WriteString("~ " + changes + "\n")
+			}
+		}
+		removed, added = removed[:0], added[:0]
+	}
+
+	i, j := 0, 0
+	for i < len(a) || j < len(b) {
+		switch {
+		case i < len(a) && j < len(b) && a[i] == b[j]:
+			flush()
+			sb.WriteString("  " + a[i] + "\n")
+			i++
+			j++
+		case j == len(b) || (i < len(a) && lcs[i+1][j] >= lcs[i][j+1]):
+			removed = append(removed, a[i])
+			i++
+		default:
+			added = append(added, b[j])
+			j++
+		}
+	}
+	flush()
+	return sb.String()
+}
+
+// attrChanges describes the attributes removed and added between two lines
+// holding a start tag with the same name, or returns an empty string when
+// they don't
+func attrChanges(want, got string) string {
+	wantTag, ok := startTag(want)
+	if !ok {
+		return ""
+	}
+	gotTag, ok := startTag(got)
+	if !ok || gotTag.Data != wantTag.Data {
+		return ""
+	}
+
+	wantAttrs := make(map[string]string, len(wantTag.Attr))
+	for _, a := range wantTag.Attr {
+		wantAttrs[attrKey(a)] = a.Val
+	}
+	gotAttrs := make(map[string]string, len(gotTag.Attr))
+	for _, a := range gotTag.Attr {
+		gotAttrs[attrKey(a)] = a.Val
+	}
+
+	var changes []string
+	for _, a := range wantTag.Attr {
+		if v, ok := gotAttrs[attrKey(a)]; !ok || v != a.Val {
+			changes = append(changes, fmt.Sprintf("-%s=%q", attrKey(a), a.Val))
+		}
+	}
+	for _, a := range gotTag.Attr {
+		if v, ok := wantAttrs[attrKey(a)]; !ok || v != a.Val {
+			changes = append(changes, fmt.Sprintf("+%s=%q", attrKey(a), a.Val))
+		}
+	}
+	if len(changes) == 0 {
+		return ""
+	}
+	return "<" + wantTag.Data + "> " + strings.Join(changes, " ")
+}
+
+// startTag tokenizes a normalized line, reporting whether it holds a start tag
+func startTag(line string) (nethtml.Token, bool) {
+	line = strings.TrimSpace(line)
+	if !strings.HasPrefix(line, "<") || strings.HasPrefix(line, "</") || strings.HasPrefix(line, "<!") {
+		return nethtml.Token{}, false
+	}
+	z := nethtml.NewTokenizer(strings.NewReader(line))
+	if z.Next() != nethtml.StartTagToken {
+		return nethtml.Token{}, false
+	}
+	return z.Token(), true
+}
+
+// preservedElements lists the elements whose whitespace is significant
+var preservedElements = map[string]bool{
+	"pre": true, "textarea": true, "script": true, "style": true,
+}
+
+// Normalize parses s and writes it back in a canonical form so that two
+// semantically equal HTML strings normalize to the same result: tag names
+// are lowercased, attributes are sorted, runs of whitespace in text are
+// collapsed to a single space and whitespace-only text is dropped, except
+// inside <pre>, <textarea>, <script> and <style>. Every tag, text and
+// comment is written on its own indented line, which keeps diffs readable.
+func Normalize(s string) (string, error) {
+	nodes, err := parse(s)
+	if err != nil {
+		return "", err
+	}
+	sb := &strings.Builder{}
+	for _, n := range nodes {
+		normalize(sb, n, 0, false)
+	}
+	return strings.TrimSuffix(sb.String(), "\n"), nil
+}
+
+// parse parses s as a document when it starts with a doctype or <html>, and
+// as a fragment of <body> otherwise
+func parse(s string) ([]*nethtml.Node, error) {
+	trimmed := strings.ToLower(strings.TrimSpace(s))
+	if strings.HasPrefix(trimmed, "<!doctype") || strings.HasPrefix(trimmed, "<html") {
+		doc, err := nethtml.Parse(strings.NewReader(s))
+		if err != nil {
+			return nil, fmt.Errorf("htmltest: parsing document: %w", err)
+		}
+		var nodes []*nethtml.Node
+		for c := doc.FirstChild; c != nil; c = c.NextSibling {
+			nodes = append(nodes, c)
+		}
+		return nodes, nil
+	}
+
+	body := &nethtml.Node{Type: nethtml.ElementNode, Data: "body", DataAtom: atom.Body}
+	nodes, err := nethtml.ParseFragment(strings.NewReader(s), body)
+	if err != nil {
+		return nil, fmt.Errorf("htmltest: parsing fragment: %w", err)
+	}
+	return nodes, nil
+}
+
+// normalize writes the canonical form of n at the given depth
+func normalize(sb *strings.Builder, n *nethtml.Node, depth int, preserve bool) {
+	indent := strings.Repeat("  ", depth)
+
+	switch n.Type {
+	case nethtml.DoctypeNode:
+		fmt.Fprintf(sb, "%s<!DOCTYPE %s>\n", indent, strings.ToLower(n.Data))
+
+	case nethtml.CommentNode:
+		fmt.Fprintf(sb, "%s<!--%s-->\n", indent, n.Data)
+
+	case nethtml.TextNode:
+		text := n.Data
+		if !preserve {
+			text = strings.Join(strings.Fields(text), " ")
+		}
+		if text != "" {
+			fmt.Fprintf(sb, "%s%s\n", indent, html.EscapeString(text))
+		}
+
+	case nethtml.ElementNode:
+		name := strings.ToLower(n.Data)
+		attrs := slices.Clone(n.Attr)
+		slices.SortFunc(attrs, func(a, b nethtml.Attribute) int {
+			return strings.Compare(attrKey(a), attrKey(b))
+		})
+
+		sb.WriteString(indent + "<" + name)
+		for _, a := range attrs {
+			fmt.Fprintf(sb, " %s=\"%s\"", attrKey(a), html.EscapeString(a.Val))
+		}
+		sb.WriteString(">\n")
+
+		for c := n.FirstChild; c != nil; c = c.NextSibling {
+			normalize(sb, c, depth+1, preserve || preservedElements[name])
+		}
+		if !isVoid(name) {
+			sb.WriteString(indent + "</" + name + ">\n")
+		}
+	}
+}
+
+// attrKey returns the attribute name including its namespace prefix
+func attrKey(a nethtml.Attribute) string {
+	if a.Namespace != "" {
+		return a.Namespace + ":" + a.Key
+	}
+	return a.Key
+}
+
+// isVoid reports whether name is a void element
+func isVoid(name string) bool {
+	switch name {
+	case "area", "base", "br", "col", "embed", "hr", "img", "input", "link", "meta", "source", "track", "wbr":
+		return true
+	}
+	return false
+}
@@ -0,0 +1,144 @@
+/**
+ * Copyright 2025 Alexis Bouchez <alexbcz@proton.me>
+ *
+ * This file is part of libhtml.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ */
+
+package htmltest_test
+
+import (
+	"fmt"
+	"testing"
+
+	html "github.com/alexisbcz/libhtml"
+	"github.com/alexisbcz/libhtml/htmltest"
+)
+
+// recorder is a testing.TB capturing reported failures
+type recorder struct {
+	testing.TB
+	errors []string
+}
+
+func (r *recorder) Helper() {}
+
+func (r *recorder) Errorf(format string, args ...any) {
+	r.errors = append(r.errors, fmt.Sprintf(format, args...))
+}
+
+func (r *recorder) Fatalf(format string, args ...any) {
+	r.Errorf(format, args...)
+}
+
+func TestAssertEqualHTML(t *testing.T) {
+	got := html.MustRenderString(html.Div(html.P(html.Text("Hello"))).Class("card").ID("main"))
+
+	htmltest.AssertEqualHTML(t, got, `
+		<DIV id="main"   class="card">
+			<p>
+				Hello
+			</p>
+		</DIV>
+	`)
+	htmltest.AssertEqualHTML(t, `<pre>  a  </pre>`, `<pre>  a  </pre>`)
+	htmltest.AssertEqualHTML(t, `<br>`, `<br/>`)
+}
+
+func TestAssertEqualHTMLMismatch(t *testing.T) {
+	tests := []struct {
+		got  string
+		want string
+	}{
+		{`<p class="a">x</p>`, `<p class="b">x</p>`},
+		{`<p>x</p>`, `<div>x</div>`},
+		{`<p>a b</p>`, `<p>ab</p>`},
+		{`<pre>a  b</pre>`, `<pre>a b</pre>`},
+	}
+
+	for _, test := range tests {
+		r := &recorder{TB: t}
+		htmltest.AssertEqualHTML(r, test.got, test.want)
+		if len(r.errors) != 1 {
+			t.Errorf("expected a mismatch between %q and %q", test.got, test.want)
+		}
+	}
+}
+
+func TestNormalize(t *testing.T) {
+	got, err := htmltest.Normalize(`<ul  id=list><LI>one<li>two</ul>`)
+	if err != nil {
+		t.Fatal(err)
+	}
+	const expected = "<ul id=\"list\">\n  <li>\n    one\n  </li>\n  <li>\n    two\n  </li>\n</ul>"
+	if got != expected {
+		t.Errorf("expected:\n%s\ngot:\n%s", expected, got)
+	}
+}
+
+func TestDiff(t *testing.T) {
+	if diff := htmltest.Diff(`<P class=a>x</P>`, `<p class="a">x</p>`); diff != "" {
+		t.Errorf("expected no diff, got:\n%s", diff)
+	}
+
+	tests := []struct {
+		name     string
+		got      string
+		want     string
+		expected string
+	}{
+		{
+			name: "attribute changed",
+			got:  `<p class="a" id="x">hi</p>`,
+			want: `<p class="b" id="x">hi</p>`,
+			expected: "- <p class=\"b\" id=\"x\">\n" +
+				"+ <p class=\"a\" id=\"x\">\n" +
+				"~ <p> -class=\"b\" +class=\"a\"\n" +
+				"    hi\n" +
+				"  </p>\n",
+		},
+		{
+			name: "element added",
+			got:  `<ul><li>one</li><li>two</li></ul>`,
+			want: `<ul><li>one</li></ul>`,
+			expected: "  <ul>\n" +
+				"    <li>\n" +
+				"      one\n" +
+				"    </li>\n" +
+				"+   <li>\n" +
+				"+     two\n" +
+				"+   </li>\n" +
+				"  </ul>\n",
+		},
+		{
+			name: "element replaced",
+			got:  `<div>x</div>`,
+			want: `<p>x</p>`,
+			expected: "- <p>\n" +
+				"+ <div>\n" +
+				"    x\n" +
+				"- </p>\n" +
+				"+ </div>\n",
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			if diff := htmltest.Diff(test.got, test.want); diff != test.expected {
+				t.Errorf("expected:\n%s\ngot:\n%s", test.expected, diff)
+			}
+		})
+	}
+}
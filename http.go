@@ -0,0 +1,63 @@
+/**
+ * Copyright 2025 Alexis Bouchez <alexbcz@proton.me>
+ *
+ * This file is part of libhtml.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ */
+
+package html
+
+import (
+	"log"
+	"net/http"
+)
+
+// Handler creates an http.Handler serving the node returned by fn
+// An error from fn or from rendering responds with a generic 500 and is logged
+// with the log package, so internal details never reach the client
+func Handler(fn func(r *http.Request) (Node, error)) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		n, err := fn(r)
+		if err != nil {
+			log.Printf("html: handler %s: %v", r.URL.Path, err)
+			internalError(w)
+			return
+		}
+		if err := WriteResponse(w, http.StatusOK, n); err != nil {
+			log.Printf("html: handler %s: %v", r.URL.Path, err)
+		}
+	})
+}
+
+// internalError responds with a 500 carrying only the status text
+func internalError(w http.ResponseWriter) {
+	http.Error(w, http.StatusText(http.StatusInternalServerError), http.StatusInternalServerError)
+}
+
+// WriteResponse renders n and writes it as an HTML response with the given status
+// The node is rendered into a buffer before anything is written, so a render
+// error still produces a clean 500 response, in which case the error is returned
+// and the response only carries the status text
+func WriteResponse(w http.ResponseWriter, status int, n Node) error {
+	b, err := RenderBytes(n)
+	if err != nil {
+		internalError(w)
+		return err
+	}
+	w.Header().Set("Content-Type", "text/html; charset=utf-8")
+	w.WriteHeader(status)
+	_, err = w.Write(b)
+	return err
+}
@@ -0,0 +1,92 @@
+/**
+ * Copyright 2025 Alexis Bouchez <alexbcz@proton.me>
+ *
+ * This file is part of libhtml.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ */
+
+package html_test
+
+import (
+	"errors"
+	"log"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"strings"
+	"testing"
+
+	. "github.com/alexisbcz/libhtml"
+)
+
+func TestHandler(t *testing.T) {
+	h := Handler(func(r *http.Request) (Node, error) {
+		switch r.URL.Path {
+		case "/fail":
+			return nil, errors.New("boom")
+		case "/broken":
+			return Div(P(Text("partial")), failingNode{}), nil
+		}
+		return P(Textf("Hello %s", r.URL.Query().Get("name"))), nil
+	})
+
+	tests := []struct {
+		target string
+		status int
+		body   string
+	}{
+		{"/?name=Go", http.StatusOK, "<p>Hello Go</p>"},
+		{"/fail", http.StatusInternalServerError, "Internal Server Error\n"},
+		{"/broken", http.StatusInternalServerError, "Internal Server Error\n"},
+	}
+
+	var logged strings.Builder
+	log.SetOutput(&logged)
+	defer log.SetOutput(os.Stderr)
+
+	for _, test := range tests {
+		rec := httptest.NewRecorder()
+		h.ServeHTTP(rec, httptest.NewRequest(http.MethodGet, test.target, nil))
+
+		if rec.Code != test.status {
+			t.Errorf("%s: expected status %d; got: %d", test.target, test.status, rec.Code)
+		}
+		if got := rec.Body.String(); got != test.body {
+			t.Errorf("%s: expected body \"%s\"; got: \"%s\"", test.target, test.body, got)
+		}
+	}
+
+	for _, msg := range []string{"html: handler /fail: boom", "html: handler /broken: rendering div: failing node"} {
+		if !strings.Contains(logged.String(), msg) {
+			t.Errorf("expected \"%s\" to be logged; got: \"%s\"", msg, logged.String())
+		}
+	}
+}
+
+func TestWriteResponse(t *testing.T) {
+	rec := httptest.NewRecorder()
+	if err := WriteResponse(rec, http.StatusNotFound, H1(Text("Not found"))); err != nil {
+		t.Fatal(err)
+	}
+	if rec.Code != http.StatusNotFound {
+		t.Errorf("expected status %d; got: %d", http.StatusNotFound, rec.Code)
+	}
+	if ct := rec.Header().Get("Content-Type"); !strings.HasPrefix(ct, "text/html") {
+		t.Errorf("expected an HTML content type; got: \"%s\"", ct)
+	}
+	if got := rec.Body.String(); got != "<h1>Not found</h1>" {
+		t.Errorf("expected: \"<h1>Not found</h1>\"; got: \"%s\"", got)
+	}
+}
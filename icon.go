@@ -0,0 +1,52 @@
+/**
+ * Copyright 2025 Alexis Bouchez <alexbcz@proton.me>
+ *
+ * This file is part of libhtml.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ */
+
+package html
+
+import "strings"
+
+// Icon creates an icon referencing the symbol with the given id in an SVG
+// sprite, as <svg><use href="#id"></use></svg>. An id already containing a
+// "#", such as "/sprite.svg#close", is used as the reference unchanged. The
+// icon is decorative, hidden from assistive technology and never focusable,
+// use IconLabeled when it carries meaning of its own.
+func Icon(id string) *svg {
+	e := SVG(Use().Href(iconRef(id)))
+	e.Attribute("aria-hidden", "true")
+	e.Attribute("focusable", "false")
+	return e
+}
+
+// IconLabeled creates an icon like Icon that is announced by assistive
+// technology as an image with the given label
+func IconLabeled(id, label string) *svg {
+	e := SVG(Use().Href(iconRef(id)))
+	e.Attribute("role", "img")
+	e.Attribute("aria-label", label)
+	e.Attribute("focusable", "false")
+	return e
+}
+
+// iconRef returns the use reference for a sprite symbol id
+func iconRef(id string) string {
+	if strings.Contains(id, "#") {
+		return id
+	}
+	return "#" + id
+}
@@ -0,0 +1,51 @@
+/**
+ * Copyright 2025 Alexis Bouchez <alexbcz@proton.me>
+ *
+ * This file is part of libhtml.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ */
+
+package html_test
+
+import (
+	"testing"
+
+	. "github.com/alexisbcz/libhtml"
+)
+
+func TestIcon(t *testing.T) {
+	tests := []struct {
+		node     Node
+		expected string
+	}{
+		{Icon("icon-close"), `<svg aria-hidden="true" focusable="false"><use href="#icon-close"></use></svg>`},
+		{Icon("/sprite.svg#close"), `<svg aria-hidden="true" focusable="false"><use href="/sprite.svg#close"></use></svg>`},
+		{IconLabeled("icon-warning", "Warning"), `<svg aria-label="Warning" focusable="false" role="img"><use href="#icon-warning"></use></svg>`},
+		{Icon("x").Class("icon"), `<svg aria-hidden="true" class="icon" focusable="false"><use href="#x"></use></svg>`},
+	}
+
+	for _, test := range tests {
+		if got := MustRenderString(test.node); got != test.expected {
+			t.Errorf("expected: \"%s\"; got: \"%s\"", test.expected, got)
+		}
+	}
+}
+
+func TestUseHref(t *testing.T) {
+	got := MustRenderString(Use().Href("#a").XlinkHref("#a").FillIf(true, "red"))
+	if expected := `<use fill="red" href="#a" xlink:href="#a"></use>`; got != expected {
+		t.Errorf("expected: \"%s\"; got: \"%s\"", expected, got)
+	}
+}
@@ -0,0 +1,433 @@
+/**
+ * Copyright 2025 Alexis Bouchez <alexbcz@proton.me>
+ *
+ * This file is part of libhtml.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ */
+
+package html
+
+import (
+	"cmp"
+	"fmt"
+	"slices"
+	"strings"
+)
+
+// cssRule is a parsed style rule with a single selector
+type cssRule struct {
+	selector    []cssCompound
+	specificity [3]int
+	order       int
+	decls       []cssDecl
+}
+
+// cssCompound is a compound selector such as "p.lead#intro"
+type cssCompound struct {
+	tag     string
+	id      string
+	classes []string
+}
+
+// cssDecl is a single "property: value" declaration
+type cssDecl struct {
+	property  string
+	value     string
+	important bool
+}
+
+// InlineCSS merges the rules of css into the style attribute of the elements
+// of root they match, for clients like email readers that ignore stylesheets.
+// Supported selectors are type selectors (including "*"), ".class", "#id",
+// compounds of them like "p.lead" and descendant combinators like "td a";
+// rules using any other selector, as well as at-rules such as @media, are
+// skipped. Declarations apply in cascade order: rules by ascending specificity
+// then source order, then the element's own style, then !important rules and
+// finally !important inline declarations. The resulting style attribute lists
+// the declarations in that cascade order, a later declaration of a property
+// replacing the earlier one, so a shorthand like margin still overrides the
+// longhands it comes after. Elements in <head>, <script> and <style> are left
+// alone.
+//
+// Conditionals, maps and groups are resolved to the nodes they stand for, as
+// the styles need concrete elements to be stored on, and root is modified in
+// place and returned. Custom nodes such as components are not looked into.
+func InlineCSS(root Node, css string) (Node, error) {
+	rules, err := parseCSS(css)
+	if err != nil {
+		return nil, err
+	}
+
+	nodes := materialize([]Node{root})
+	for _, n := range nodes {
+		inlineCSS(n, rules, nil)
+	}
+	if len(nodes) == 1 {
+		return nodes[0], nil
+	}
+	return Group(nodes...), nil
+}
+
+// materialize resolves expanders in nodes recursively, replacing the children
+// of elements with the resolved nodes so changes made to them are kept when
+// the tree is rendered. Keyed and WithNonce wrappers are kept as they change
+// how their children render.
+func materialize(nodes []Node) []Node {
+	resolved := make([]Node, 0, len(nodes))
+	for _, n := range nodes {
+		switch v := n.(type) {
+		case nil:
+			continue
+		case *keyed:
+			v.node = Group(materialize([]Node{v.node})...)
+			resolved = append(resolved, v)
+		case *withNonce:
+			v.node = Group(materialize([]Node{v.node})...)
+			resolved = append(resolved, v)
+		case *withMode:
+			v.node = Group(materialize([]Node{v.node})...)
+			resolved = append(resolved, v)
+		case *document:
+			v.children = materialize(v.children)
+			resolved = append(resolved, v)
+		case expander:
+			resolved = append(resolved, materialize(v.expand())...)
+		case element:
+			t := v.base()
+			t.children = materialize(t.children)
+			resolved = append(resolved, n)
+		default:
+			resolved = append(resolved, n)
+		}
+	}
+	return resolved
+}
+
+// inlineCSS applies rules to n and its descendants, ancestors holding the
+// elements enclosing n from the outermost one
+func inlineCSS(n Node, rules []cssRule, ancestors []*Tag) {
+	switch v := n.(type) {
+	case *document:
+		for _, c := range v.children {
+			inlineCSS(c, rules, ancestors)
+		}
+	case expander:
+		for _, c := range v.expand() {
+			inlineCSS(c, rules, ancestors)
+		}
+	case element:
+		t := v.base()
+		switch t.name {
+		case "head", "script", "style":
+			return
+		}
+		applyCSS(t, rules, ancestors)
+		ancestors = append(ancestors, t)
+		for _, c := range t.children {
+			inlineCSS(c, rules, ancestors)
+		}
+	}
+}
+
+// applyCSS merges the declarations of the rules matching t into its style
+func applyCSS(t *Tag, rules []cssRule, ancestors []*Tag) {
+	var matched []cssRule
+	for _, r := range rules {
+		if r.matches(t, ancestors) {
+			matched = append(matched, r)
+		}
+	}
+	if len(matched) == 0 {
+		return
+	}
+	slices.SortStableFunc(matched, func(a, b cssRule) int {
+		if c := slices.Compare(a.specificity[:], b.specificity[:]); c != 0 {
+			return c
+		}
+		return cmp.Compare(a.order, b.order)
+	})
+
+	inline := parseDeclarations(t.attributes["style"])
+	var styles []cssDecl
+	apply := func(d cssDecl) {
+		styles = slices.DeleteFunc(styles, func(s cssDecl) bool { return s.property == d.property })
+		styles = append(styles, d)
+	}
+	for _, important := range []bool{false, true} {
+		for _, r := range matched {
+			for _, d := range r.decls {
+				if d.important == important {
+					apply(d)
+				}
+			}
+		}
+		for _, d := range inline {
+			if d.important == important {
+				apply(d)
+			}
+		}
+	}
+
+	sb := &strings.Builder{}
+	for _, d := range styles {
+		sb.WriteString(d.property)
+		sb.WriteString(":")
+		sb.WriteString(d.value)
+		sb.WriteString(";")
+	}
+	t.Attribute("style", sb.String())
+}
+
+// matches reports whether t, enclosed by ancestors, matches the selector
+func (r cssRule) matches(t *Tag, ancestors []*Tag) bool {
+	last := len(r.selector) - 1
+	if !r.selector[last].matches(t) {
+		return false
+	}
+	i := len(ancestors) - 1
+	for j := last - 1; j >= 0; j-- {
+		for i >= 0 && !r.selector[j].matches(ancestors[i]) {
+			i--
+		}
+		if i < 0 {
+			return false
+		}
+		i--
+	}
+	return true
+}
+
+// matches reports whether t matches the compound selector
+func (c cssCompound) matches(t *Tag) bool {
+	if c.tag != "" && c.tag != "*" && !strings.EqualFold(c.tag, t.name) {
+		return false
+	}
+	if c.id != "" && t.attributes["id"] != c.id {
+		return false
+	}
+	classes := strings.Fields(t.attributes["class"])
+	for _, class := range c.classes {
+		if !slices.Contains(classes, class) {
+			return false
+		}
+	}
+	return true
+}
+
+// parseCSS parses the style rules of css, one per selector of each rule
+func parseCSS(css string) ([]cssRule, error) {
+	css, err := stripCSSComments(css)
+	if err != nil {
+		return nil, err
+	}
+
+	var rules []cssRule
+	for {
+		css = strings.TrimSpace(css)
+		if css == "" {
+			return rules, nil
+		}
+
+		open := strings.IndexByte(css, '{')
+		if css[0] == '@' {
+			// statement at-rules like @import end with a semicolon
+			if semi := strings.IndexByte(css, ';'); semi >= 0 && (open < 0 || semi < open) {
+				css = css[semi+1:]
+				continue
+			}
+		}
+		if open < 0 {
+			return nil, fmt.Errorf("html: css: expected '{' after %q", css)
+		}
+		end, err := cssBlockEnd(css, open)
+		if err != nil {
+			return nil, err
+		}
+		prelude, body := strings.TrimSpace(css[:open]), css[open+1:end]
+		css = css[end+1:]
+
+		if strings.HasPrefix(prelude, "@") {
+			continue
+		}
+		decls := parseDeclarations(body)
+		for _, s := range strings.Split(prelude, ",") {
+			selector, specificity, ok := parseSelector(s)
+			if !ok {
+				continue
+			}
+			rules = append(rules, cssRule{
+				selector:    selector,
+				specificity: specificity,
+				order:       len(rules),
+				decls:       decls,
+			})
+		}
+	}
+}
+
+// stripCSSComments removes /* ... */ comments from css
+func stripCSSComments(css string) (string, error) {
+	var sb strings.Builder
+	for {
+		start := strings.Index(css, "/*")
+		if start < 0 {
+			sb.WriteString(css)
+			return sb.String(), nil
+		}
+		end := strings.Index(css[start+2:], "*/")
+		if end < 0 {
+			return "", fmt.Errorf("html: css: unterminated comment")
+		}
+		sb.WriteString(css[:start])
+		sb.WriteString(" ")
+		css = css[start+2+end+2:]
+	}
+}
+
+// cssBlockEnd returns the index of the '}' closing the block opened at open
+func cssBlockEnd(css string, open int) (int, error) {
+	depth := 0
+	for i := open; i < len(css); i++ {
+		switch css[i] {
+		case '{':
+			depth++
+		case '}':
+			depth--
+			if depth == 0 {
+				return i, nil
+			}
+		}
+	}
+	return 0, fmt.Errorf("html: css: unclosed block %q", css[:open])
+}
+
+// parseSelector parses a selector made of compounds separated by whitespace,
+// reporting false for selectors using unsupported syntax
+func parseSelector(s string) ([]cssCompound, [3]int, bool) {
+	var specificity [3]int
+	fields := strings.Fields(s)
+	if len(fields) == 0 {
+		return nil, specificity, false
+	}
+	selector := make([]cssCompound, 0, len(fields))
+	for _, f := range fields {
+		c, ok := parseCompound(f)
+		if !ok {
+			return nil, specificity, false
+		}
+		if c.id != "" {
+			specificity[0]++
+		}
+		specificity[1] += len(c.classes)
+		if c.tag != "" && c.tag != "*" {
+			specificity[2]++
+		}
+		selector = append(selector, c)
+	}
+	return selector, specificity, true
+}
+
+// parseCompound parses a compound selector such as "p.lead#intro"
+func parseCompound(s string) (cssCompound, bool) {
+	var c cssCompound
+	name := func(s string) int {
+		i := 0
+		for i < len(s) && (isASCIIAlnum(s[i]) || s[i] == '-' || s[i] == '_') {
+			i++
+		}
+		return i
+	}
+
+	if strings.HasPrefix(s, "*") {
+		c.tag, s = "*", s[1:]
+	} else if n := name(s); n > 0 {
+		c.tag, s = s[:n], s[n:]
+	}
+	for s != "" {
+		kind := s[0]
+		n := name(s[1:])
+		if n == 0 || (kind != '.' && kind != '#') {
+			return c, false
+		}
+		value := s[1 : n+1]
+		s = s[n+1:]
+		if kind == '.' {
+			c.classes = append(c.classes, value)
+		} else if c.id == "" || c.id == value {
+			c.id = value
+		} else {
+			return c, false
+		}
+	}
+	return c, true
+}
+
+// isASCIIAlnum reports whether b is an ASCII letter or digit
+func isASCIIAlnum(b byte) bool {
+	return b >= 'a' && b <= 'z' || b >= 'A' && b <= 'Z' || b >= '0' && b <= '9'
+}
+
+// parseDeclarations parses a semicolon-separated list of declarations
+func parseDeclarations(s string) []cssDecl {
+	var decls []cssDecl
+	for _, part := range splitDeclarations(s) {
+		property, value, ok := strings.Cut(part, ":")
+		if !ok {
+			continue
+		}
+		d := cssDecl{property: strings.TrimSpace(property), value: strings.TrimSpace(value)}
+		if !strings.HasPrefix(d.property, "--") {
+			// custom properties are case-sensitive, the others aren't
+			d.property = strings.ToLower(d.property)
+		}
+		if i := strings.LastIndexByte(d.value, '!'); i >= 0 &&
+			strings.EqualFold(strings.TrimSpace(d.value[i+1:]), "important") {
+			d.value, d.important = strings.TrimSpace(d.value[:i]), true
+		}
+		if d.property == "" || d.value == "" {
+			continue
+		}
+		decls = append(decls, d)
+	}
+	return decls
+}
+
+// splitDeclarations splits s on the semicolons separating declarations,
+// leaving alone the ones inside parentheses or quotes such as the semicolon of
+// url(data:image/png;base64,...)
+func splitDeclarations(s string) []string {
+	var parts []string
+	depth, quote, start := 0, byte(0), 0
+	for i := 0; i < len(s); i++ {
+		switch c := s[i]; {
+		case c == '\\':
+			i++
+		case quote != 0:
+			if c == quote {
+				quote = 0
+			}
+		case c == '"' || c == '\'':
+			quote = c
+		case c == '(':
+			depth++
+		case c == ')' && depth > 0:
+			depth--
+		case c == ';' && depth == 0:
+			parts = append(parts, s[start:i])
+			start = i + 1
+		}
+	}
+	return append(parts, s[start:])
+}
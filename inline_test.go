@@ -0,0 +1,96 @@
+/**
+ * Copyright 2025 Alexis Bouchez <alexbcz@proton.me>
+ *
+ * This file is part of libhtml.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ */
+
+package html_test
+
+import (
+	"testing"
+
+	. "github.com/alexisbcz/libhtml"
+)
+
+func TestInlineCSS(t *testing.T) {
+	css := `
+		/* base */
+		@import url("fonts.css");
+		p { color: black; margin: 0 }
+		.lead { color: navy; font-size: 18px }
+		#intro { color: red }
+		td a, .footer a { color: gray; text-decoration: none !important }
+		* { font-family: Arial }
+		@media (max-width: 600px) { p { color: green } }
+		p:hover, a[href] { color: pink }
+	`
+
+	tree := Document(HTML(
+		Head(Style(Raw("p{color:blue}"))),
+		Body(
+			P(Text("intro")).Class("lead").ID("intro"),
+			P(Text("lead")).Class("lead").Style("color: orange; text-decoration: underline"),
+			Table(Tr(Td(A(Text("link")).Style("text-decoration: underline")))),
+			Map([]string{"x"}, func(s string) Node { return Div(A(Text(s))).Class("footer") }),
+		),
+	))
+
+	n, err := InlineCSS(tree, css)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	expected := `<!DOCTYPE html><html style="font-family:Arial;">` +
+		`<head><style>p{color:blue}</style></head>` +
+		`<body style="font-family:Arial;">` +
+		`<p class="lead" id="intro" style="font-family:Arial;margin:0;font-size:18px;color:red;">intro</p>` +
+		`<p class="lead" style="font-family:Arial;margin:0;font-size:18px;color:orange;text-decoration:underline;">lead</p>` +
+		`<table style="font-family:Arial;"><tr style="font-family:Arial;"><td style="font-family:Arial;">` +
+		`<a style="font-family:Arial;color:gray;text-decoration:none;">link</a></td></tr></table>` +
+		`<div class="footer" style="font-family:Arial;"><a style="font-family:Arial;color:gray;text-decoration:none;">x</a></div>` +
+		`</body></html>`
+	if got := MustRenderString(n); got != expected {
+		t.Errorf("expected: \"%s\"; got: \"%s\"", expected, got)
+	}
+}
+
+func TestInlineCSSCascadeOrder(t *testing.T) {
+	css := `p { margin-top: 10px } p.flush { margin: 0 } .icon { background: url("data:image/png;base64,iVBO;R") no-repeat; color: red }`
+	tree := Div(P(Text("a")).Class("flush"), Span().Class("icon").Style("background-image: url(data:image/png;base64,AAAA); margin: 1px"))
+
+	n, err := InlineCSS(tree, css)
+	if err != nil {
+		t.Fatal(err)
+	}
+	expected := `<div><p class="flush" style="margin-top:10px;margin:0;">a</p>` +
+		`<span class="icon" style="background:url(&#34;data:image/png;base64,iVBO;R&#34;) no-repeat;color:red;` +
+		`background-image:url(data:image/png;base64,AAAA);margin:1px;"></span></div>`
+	if got := MustRenderString(n); got != expected {
+		t.Errorf("expected: \"%s\"; got: \"%s\"", expected, got)
+	}
+}
+
+func TestInlineCSSInvalid(t *testing.T) {
+	for _, css := range []string{
+		`p { color: red`,
+		`p color: red }`,
+		`/* unterminated`,
+	} {
+		if _, err := InlineCSS(Div(), css); err == nil {
+			t.Errorf("expected an error for %q", css)
+		}
+	}
+}
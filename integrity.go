@@ -0,0 +1,71 @@
+/**
+ * Copyright 2025 Alexis Bouchez <alexbcz@proton.me>
+ *
+ * This file is part of libhtml.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ */
+
+package html
+
+import (
+	"crypto/sha256"
+	"crypto/sha512"
+	"encoding/base64"
+	"fmt"
+)
+
+// integrityDigest returns the subresource integrity value of content, which is
+// the algorithm name followed by the base64 digest, e.g. "sha384-..."
+// Panics if algo isn't one of the algorithms allowed by SRI: sha256, sha384
+// or sha512
+func integrityDigest(content []byte, algo string) string {
+	var sum []byte
+	switch algo {
+	case "sha256":
+		digest := sha256.Sum256(content)
+		sum = digest[:]
+	case "sha384":
+		digest := sha512.Sum384(content)
+		sum = digest[:]
+	case "sha512":
+		digest := sha512.Sum512(content)
+		sum = digest[:]
+	default:
+		panic(fmt.Sprintf("html: unsupported integrity algorithm %q", algo))
+	}
+	return algo + "-" + base64.StdEncoding.EncodeToString(sum)
+}
+
+// IntegrityFrom sets the "integrity" attribute to the digest of content using
+// algo, which must be "sha256", "sha384" or "sha512", along with
+// crossorigin="anonymous" so the browser can check it
+// content is the exact file served at the src of the script; browsers ignore
+// integrity on inline scripts, which are allowed by a CSP hash-source instead
+// Panics on any other algorithm
+// Returns the element itself to enable method chaining
+func (e *script) IntegrityFrom(content []byte, algo string) *script {
+	e.Attribute("integrity", integrityDigest(content, algo))
+	e.Attribute("crossorigin", "anonymous")
+	return e
+}
+
+// IntegrityFrom sets the "integrity" and "crossorigin" attributes for the
+// stylesheet content like script.IntegrityFrom does
+// Returns the element itself to enable method chaining
+func (e *link) IntegrityFrom(content []byte, algo string) *link {
+	e.Attribute("integrity", integrityDigest(content, algo))
+	e.Attribute("crossorigin", "anonymous")
+	return e
+}
@@ -0,0 +1,61 @@
+/**
+ * Copyright 2025 Alexis Bouchez <alexbcz@proton.me>
+ *
+ * This file is part of libhtml.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ */
+
+package html_test
+
+import (
+	"testing"
+
+	. "github.com/alexisbcz/libhtml"
+)
+
+func TestIntegrityFrom(t *testing.T) {
+	body := []byte("alert(1)")
+
+	tests := []struct {
+		node     Node
+		expected string
+	}{
+		{
+			Script().Src("/app.js").IntegrityFrom(body, "sha384"),
+			`<script crossorigin="anonymous" integrity="sha384-HT2E9NfWiuQ/w1PRai+hTyqW16NIoCGA/m8VQDUopfAtcz6YQjtsMmQd5uRbVDpW" src="/app.js"></script>`,
+		},
+		{
+			Link().Rel("stylesheet").Href("/app.css").IntegrityFrom(body, "sha256"),
+			`<link crossorigin="anonymous" href="/app.css" integrity="sha256-bhHHL3z2vDgxUt0W3dWQOrprscmda2Y5pLsLg4GF+pI=" rel="stylesheet"/>`,
+		},
+	}
+
+	for _, test := range tests {
+		if got := MustRenderString(test.node); got != test.expected {
+			t.Errorf("expected: \"%s\"; got: \"%s\"", test.expected, got)
+		}
+	}
+
+	for _, algo := range []string{"", "md5", "sha1", "SHA384"} {
+		func() {
+			defer func() {
+				if recover() == nil {
+					t.Errorf("expected IntegrityFrom to panic on %q", algo)
+				}
+			}()
+			Script().IntegrityFrom(body, algo)
+		}()
+	}
+}
@@ -0,0 +1,212 @@
+/**
+ * Copyright 2025 Alexis Bouchez <alexbcz@proton.me>
+ *
+ * This file is part of libhtml.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ */
+
+package html
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"maps"
+	"slices"
+)
+
+// jsonNode is the JSON representation of a node
+// Exactly one of Tag, Text, Raw, Comment or Document is set, a node with none
+// of them is a group of its children
+type jsonNode struct {
+	Tag      string            `json:"tag,omitempty"`
+	Void     bool              `json:"void,omitempty"`
+	Attrs    map[string]string `json:"attrs,omitempty"`
+	Booleans []string          `json:"booleans,omitempty"`
+	Text     *string           `json:"text,omitempty"`
+	Raw      *string           `json:"raw,omitempty"`
+	Comment  *string           `json:"comment,omitempty"`
+	Document bool              `json:"document,omitempty"`
+	Doctype  *string           `json:"doctype,omitempty"`
+	Children []*jsonNode       `json:"children,omitempty"`
+}
+
+// MarshalJSON serializes the tree rooted at n to JSON
+// Elements become {"tag":"div","attrs":{...},"children":[...]}, with "void"
+// set for void elements and "booleans" listing the attributes set with
+// BooleanAttribute, text becomes {"text":"..."}, raw HTML {"raw":"..."},
+// comments {"comment":"..."} and documents {"document":true,"children":[...]},
+// with "doctype" set when it isn't the default one.
+// Conditionals, maps and groups are resolved to the nodes they stand for, and
+// any other node, such as a component, is rendered and stored as raw HTML.
+// Markup characters are kept as is rather than escaped to \u003c and friends.
+func MarshalJSON(n Node) ([]byte, error) {
+	j := &jsonNode{}
+	if n != nil {
+		var err error
+		if j, err = toJSONNode(n); err != nil {
+			return nil, err
+		}
+	}
+
+	var buf bytes.Buffer
+	enc := json.NewEncoder(&buf)
+	enc.SetEscapeHTML(false)
+	if err := enc.Encode(j); err != nil {
+		return nil, fmt.Errorf("html: %w", err)
+	}
+	return bytes.TrimSuffix(buf.Bytes(), []byte("\n")), nil
+}
+
+// toJSONNode converts n, resolving expanders and wrappers into a group when
+// they don't stand for exactly one node
+func toJSONNode(n Node) (*jsonNode, error) {
+	_, isExpander := n.(expander)
+	if _, isWrapper := n.(wrapper); isExpander || isWrapper {
+		nodes := flatten([]Node{n})
+		if len(nodes) == 1 {
+			return toJSONNode(nodes[0])
+		}
+		children, err := toJSONNodes(nodes)
+		if err != nil {
+			return nil, err
+		}
+		return &jsonNode{Children: children}, nil
+	}
+
+	switch v := n.(type) {
+	case *document:
+		children, err := toJSONNodes(v.children)
+		if err != nil {
+			return nil, err
+		}
+		j := &jsonNode{Document: true, Children: children}
+		if v.doctype != defaultDoctype {
+			j.Doctype = &v.doctype
+		}
+		return j, nil
+	case *text:
+		return &jsonNode{Text: &v.content}, nil
+	case *raw:
+		return &jsonNode{Raw: &v.content}, nil
+	case *comment:
+		return &jsonNode{Comment: &v.content}, nil
+	case element:
+		t := v.base()
+		children, err := toJSONNodes(t.children)
+		if err != nil {
+			return nil, err
+		}
+		j := &jsonNode{Tag: t.name, Void: t.isVoid, Children: children}
+		if len(t.attributes) > 0 {
+			j.Attrs = t.attributes
+		}
+		for _, key := range slices.Sorted(maps.Keys(t.boolean)) {
+			if _, ok := t.attributes[key]; ok {
+				j.Booleans = append(j.Booleans, key)
+			}
+		}
+		return j, nil
+	}
+
+	s, err := RenderString(n)
+	if err != nil {
+		return nil, err
+	}
+	return &jsonNode{Raw: &s}, nil
+}
+
+// toJSONNodes converts nodes after resolving expanders and dropping nil entries
+func toJSONNodes(nodes []Node) ([]*jsonNode, error) {
+	nodes = flatten(nodes)
+	if len(nodes) == 0 {
+		return nil, nil
+	}
+	children := make([]*jsonNode, 0, len(nodes))
+	for _, n := range nodes {
+		j, err := toJSONNode(n)
+		if err != nil {
+			return nil, err
+		}
+		children = append(children, j)
+	}
+	return children, nil
+}
+
+// UnmarshalJSON rebuilds a tree serialized by MarshalJSON
+// Elements are returned as *Tag, so element specific setters aren't available
+// on the result. Tag and attribute names are validated like El and Attribute
+// do, returning an error instead of building markup that could be injected.
+func UnmarshalJSON(data []byte) (Node, error) {
+	var j jsonNode
+	if err := json.Unmarshal(data, &j); err != nil {
+		return nil, fmt.Errorf("html: %w", err)
+	}
+	return fromJSONNode(&j)
+}
+
+// fromJSONNode converts a JSON node back to a node
+func fromJSONNode(j *jsonNode) (Node, error) {
+	if j == nil {
+		return nil, fmt.Errorf("html: null node")
+	}
+
+	children := make([]Node, 0, len(j.Children))
+	for _, c := range j.Children {
+		n, err := fromJSONNode(c)
+		if err != nil {
+			return nil, err
+		}
+		children = append(children, n)
+	}
+
+	switch {
+	case j.Tag != "":
+		if !isValidTagName(j.Tag) {
+			return nil, fmt.Errorf("html: invalid tag name %q", j.Tag)
+		}
+		void := j.Void || voidElements[j.Tag]
+		if void && len(children) > 0 {
+			return nil, fmt.Errorf("html: void element %q has children", j.Tag)
+		}
+		t := NewTag(j.Tag, void, children)
+		for key, value := range j.Attrs {
+			if !isValidAttributeName(key) {
+				return nil, fmt.Errorf("html: invalid attribute name %q", key)
+			}
+			t.attributes[key] = value
+		}
+		for _, key := range j.Booleans {
+			if !isValidAttributeName(key) {
+				return nil, fmt.Errorf("html: invalid attribute name %q", key)
+			}
+			t.BooleanAttribute(key)
+		}
+		return t, nil
+	case j.Text != nil:
+		return Text(*j.Text), nil
+	case j.Raw != nil:
+		return Raw(*j.Raw), nil
+	case j.Comment != nil:
+		return Comment(*j.Comment), nil
+	case j.Document:
+		d := Document(children...)
+		if j.Doctype != nil {
+			d.Doctype(*j.Doctype)
+		}
+		return d, nil
+	}
+	return Group(children...), nil
+}
@@ -0,0 +1,99 @@
+/**
+ * Copyright 2025 Alexis Bouchez <alexbcz@proton.me>
+ *
+ * This file is part of libhtml.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ */
+
+package html_test
+
+import (
+	"testing"
+
+	. "github.com/alexisbcz/libhtml"
+)
+
+func TestMarshalJSON(t *testing.T) {
+	tests := []struct {
+		node     Node
+		expected string
+	}{
+		{
+			Div(Text("a < b"), Br()).Class("box"),
+			`{"tag":"div","attrs":{"class":"box"},"children":[{"text":"a < b"},{"tag":"br","void":true}]}`,
+		},
+		{P(Raw("<b>x</b>"), Comment("note")), `{"tag":"p","children":[{"raw":"<b>x</b>"},{"comment":"note"}]}`},
+		{Group(Text(""), If(false, P())), `{"text":""}`},
+		{Document(), `{"document":true}`},
+		{Details().Open(), `{"tag":"details","attrs":{"open":""},"booleans":["open"]}`},
+		{C(Card{Title: "Hi"}), `{"raw":"<div class=\"card\"><h2>Hi</h2><p></p></div>"}`},
+	}
+
+	for _, test := range tests {
+		got, err := MarshalJSON(test.node)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if string(got) != test.expected {
+			t.Errorf("expected: %s; got: %s", test.expected, got)
+		}
+	}
+}
+
+func TestUnmarshalJSONRoundTrip(t *testing.T) {
+	tree := Document(HTML(
+		Head(Title(Text("Round & trip"))),
+		Body(
+			Comment("main"),
+			Ul(Map([]string{"a", "b"}, func(s string) Node { return Li(Text(s)).Class("item") })),
+			Input().Type("checkbox").AttributeIf(true, "checked", ""),
+			Details(Summary(Text("More"))).Open(),
+			Raw("<hr>"),
+		),
+	).Lang("en"))
+
+	data, err := MarshalJSON(tree)
+	if err != nil {
+		t.Fatal(err)
+	}
+	n, err := UnmarshalJSON(data)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	expected := MustRenderString(tree)
+	if got := MustRenderString(n); got != expected {
+		t.Errorf("expected: \"%s\"; got: \"%s\"", expected, got)
+	}
+	expected = MustRenderString(WithMode(tree, XHTML))
+	if got := MustRenderString(WithMode(n, XHTML)); got != expected {
+		t.Errorf("expected: \"%s\"; got: \"%s\"", expected, got)
+	}
+}
+
+func TestUnmarshalJSONInvalid(t *testing.T) {
+	for _, data := range []string{
+		`{"tag":"div><script"}`,
+		`{"tag":"div","attrs":{"onclick=\"x":"1"}}`,
+		`{"tag":"br","void":true,"children":[{"text":"x"}]}`,
+		`{"tag":"details","booleans":["open x"]}`,
+		`{"children":[null]}`,
+		`not json`,
+	} {
+		if n, err := UnmarshalJSON([]byte(data)); err == nil {
+			t.Errorf("expected an error for %s; got: %v", data, n)
+		}
+	}
+}
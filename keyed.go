@@ -0,0 +1,71 @@
+/**
+ * Copyright 2025 Alexis Bouchez <alexbcz@proton.me>
+ *
+ * This file is part of libhtml.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ */
+
+package html
+
+import (
+	"context"
+	"io"
+)
+
+// KeyAttribute is the attribute Keyed stamps keys into
+var KeyAttribute = "data-key"
+
+// keyed renders its child elements with a key attribute
+type keyed struct {
+	key  string
+	node Node
+}
+
+// Keyed creates a node rendering n with its key stamped into the KeyAttribute
+// attribute, giving client-side code a stable identity for reconciliation.
+// When n resolves to several nodes, e.g. a Group, every element among them is
+// stamped. The tree itself isn't modified and the key is escaped like any
+// other attribute value.
+func Keyed(key string, n Node) Node {
+	return &keyed{key: key, node: n}
+}
+
+// KeyedMap renders a collection of items like Map, stamping each rendered
+// element with the key derived from its item
+func KeyedMap[T any](items []T, key func(item T) string, transform func(item T) Node) Node {
+	return Map(items, func(item T) Node {
+		return Keyed(key(item), transform(item))
+	})
+}
+
+// Render implements Node.Render for keyed
+func (k *keyed) Render(w io.Writer) error {
+	return k.RenderContext(context.Background(), w)
+}
+
+// RenderContext implements ContextNode for keyed
+func (k *keyed) RenderContext(ctx context.Context, w io.Writer) error {
+	for _, item := range flattenContext(ctx, []Node{k}, nil) {
+		if err := item.render(w); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// unwrap implements wrapper for keyed
+func (k *keyed) unwrap() Node {
+	return k.node
+}
@@ -0,0 +1,77 @@
+/**
+ * Copyright 2025 Alexis Bouchez <alexbcz@proton.me>
+ *
+ * This file is part of libhtml.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ */
+
+package html_test
+
+import (
+	"testing"
+
+	. "github.com/alexisbcz/libhtml"
+)
+
+func TestKeyed(t *testing.T) {
+	type todo struct {
+		ID    string
+		Title string
+	}
+	todos := []todo{{ID: "1", Title: "Write"}, {ID: `"2"`, Title: "Ship"}}
+
+	tests := []struct {
+		node     Node
+		expected string
+	}{
+		{Keyed("a", Li(Text("x")).Class("item")), `<li class="item" data-key="a">x</li>`},
+		{Keyed("b", Group(Dt(Text("t")), Text(" "), Dd(Text("d")))), `<dt data-key="b">t</dt> <dd data-key="b">d</dd>`},
+		{Keyed("c", Text("plain")), `plain`},
+		{Keyed("k", WithNonce(Script(), "N")), `<script data-key="k" nonce="N"></script>`},
+		{Keyed("outer", Keyed("inner", Li())), `<li data-key="inner"></li>`},
+		{Keyed("outer", Group(Keyed("inner", Li()), Li())), `<li data-key="inner"></li><li data-key="outer"></li>`},
+		{Keyed("k", WithMode(Br(), HTML5)), `<br data-key="k">`},
+		{WithMode(Keyed("k", Br()), HTML5), `<br data-key="k">`},
+		{
+			Ul(KeyedMap(todos, func(t todo) string { return t.ID }, func(t todo) Node {
+				return Li(Text(t.Title))
+			})),
+			`<ul><li data-key="1">Write</li><li data-key="&#34;2&#34;">Ship</li></ul>`,
+		},
+	}
+
+	for _, test := range tests {
+		got, err := RenderString(test.node)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if got != test.expected {
+			t.Errorf("expected: \"%s\"; got: \"%s\"", test.expected, got)
+		}
+	}
+}
+
+func TestKeyAttribute(t *testing.T) {
+	defer func(attribute string) { KeyAttribute = attribute }(KeyAttribute)
+	KeyAttribute = "data-island-key"
+
+	li := Li(Text("x"))
+	if got := MustRenderString(Keyed("k", li)); got != `<li data-island-key="k">x</li>` {
+		t.Errorf("unexpected render: \"%s\"", got)
+	}
+	if got := MustRenderString(li); got != `<li>x</li>` {
+		t.Errorf("expected the wrapped element to be left untouched; got: \"%s\"", got)
+	}
+}
@@ -0,0 +1,115 @@
+/**
+ * Copyright 2025 Alexis Bouchez <alexbcz@proton.me>
+ *
+ * This file is part of libhtml.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ */
+
+package html
+
+import (
+	"context"
+	"io"
+)
+
+// Slots holds the named placeholders of a layout
+type Slots struct {
+	nodes map[string]Node
+}
+
+// Set fills the slot with the given name
+func (s *Slots) Set(name string, n Node) {
+	if s.nodes == nil {
+		s.nodes = make(map[string]Node)
+	}
+	s.nodes[name] = n
+}
+
+// Get returns the node set for the slot with the given name, or nil
+func (s *Slots) Get(name string) Node {
+	return s.nodes[name]
+}
+
+// Slot creates a placeholder node that renders whatever was set for name
+// The slot is resolved at render time and renders nothing when unfilled
+func (s *Slots) Slot(name string) Node {
+	return &slot{slots: s, name: name}
+}
+
+// slot renders the node set for its name in a Slots
+type slot struct {
+	slots *Slots
+	name  string
+}
+
+// Render implements Node.Render for slot
+func (s *slot) Render(w io.Writer) error {
+	return s.RenderContext(context.Background(), w)
+}
+
+// RenderContext implements ContextNode for slot
+func (s *slot) RenderContext(ctx context.Context, w io.Writer) error {
+	if n := s.slots.Get(s.name); n != nil {
+		return RenderContext(ctx, n, w)
+	}
+	return nil
+}
+
+// expand implements expander for slot
+func (s *slot) expand() []Node {
+	return []Node{s.slots.Get(s.name)}
+}
+
+// layout is a reusable page shell whose slots are filled by each page
+type layout struct {
+	slots *Slots
+	root  Node
+}
+
+// Layout creates a layout from a define function building the page shell
+// The function receives the layout slots and places them with Slots.Slot.
+// Each call creates a fresh set of slots, so a layout is typically wrapped in
+// a function that pages call before filling it.
+func Layout(define func(slots *Slots) Node) *layout {
+	slots := &Slots{}
+	return &layout{
+		slots: slots,
+		root:  define(slots),
+	}
+}
+
+// Fill sets the children rendered by the slot with the given name
+func (l *layout) Fill(name string, children ...Node) *layout {
+	l.slots.Set(name, Group(children...))
+	return l
+}
+
+// Render implements Node.Render for layout
+func (l *layout) Render(w io.Writer) error {
+	return l.RenderContext(context.Background(), w)
+}
+
+// RenderContext implements ContextNode for layout
+func (l *layout) RenderContext(ctx context.Context, w io.Writer) error {
+	if l.root == nil {
+		return nil
+	}
+	return RenderContext(ctx, l.root, w)
+}
+
+// expand implements expander for layout
+func (l *layout) expand() []Node {
+	return []Node{l.root}
+}
@@ -0,0 +1,63 @@
+/**
+ * Copyright 2025 Alexis Bouchez <alexbcz@proton.me>
+ *
+ * This file is part of libhtml.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ */
+
+package html_test
+
+import (
+	"testing"
+
+	. "github.com/alexisbcz/libhtml"
+)
+
+func TestLayout(t *testing.T) {
+	page := Layout(func(slots *Slots) Node {
+		return Document(HTML(
+			Head(Title(slots.Slot("title")), slots.Slot("head")),
+			Body(
+				Main(slots.Slot("main")),
+				Footer(slots.Slot("footer")),
+			),
+		))
+	}).
+		Fill("title", Text("Home")).
+		Fill("main", H1(Text("Welcome")), P(Text("Hello")))
+
+	got, err := RenderString(page)
+	if err != nil {
+		t.Fatal(err)
+	}
+	const expected = `<!DOCTYPE html><html><head><title>Home</title></head><body><main><h1>Welcome</h1><p>Hello</p></main><footer></footer></body></html>`
+	if got != expected {
+		t.Errorf("expected: \"%s\"; got: \"%s\"", expected, got)
+	}
+}
+
+func TestSlots(t *testing.T) {
+	slots := &Slots{}
+	if slots.Get("missing") != nil {
+		t.Error("expected an unset slot to be nil")
+	}
+
+	slot := slots.Slot("greeting")
+	slots.Set("greeting", Text("hi"))
+
+	if got := MustRenderString(Div(slot)); got != "<div>hi</div>" {
+		t.Errorf("expected: \"<div>hi</div>\"; got: \"%s\"", got)
+	}
+}
@@ -0,0 +1,87 @@
+/**
+ * Copyright 2025 Alexis Bouchez <alexbcz@proton.me>
+ *
+ * This file is part of libhtml.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ */
+
+package html
+
+// List creates an unordered list with every item wrapped in an <li>, items
+// that already are <li> elements are kept as they are. Conditionals, maps and
+// groups are resolved when the list is created, so each node they stand for
+// becomes its own item. Keyed and WithNonce items are kept intact, counting as
+// <li> elements when everything they wrap is one.
+func List(items ...Node) *ul {
+	return Ul(listItems(items)...)
+}
+
+// OrderedList creates an ordered list with every item wrapped in an <li> like List
+func OrderedList(items ...Node) *ol {
+	return Ol(listItems(items)...)
+}
+
+// ListOf creates an unordered list with an item for each element of items
+func ListOf[T any](items []T, fn func(item T) Node) *ul {
+	nodes := make([]Node, 0, len(items))
+	for _, item := range items {
+		nodes = append(nodes, fn(item))
+	}
+	return List(nodes...)
+}
+
+// listItems wraps every node that isn't an <li> element in one
+func listItems(items []Node) []Node {
+	items = expandAll(items, nil)
+	for i, item := range items {
+		if isListItem(item) {
+			continue
+		}
+		items[i] = Li(item)
+	}
+	return items
+}
+
+// expandAll resolves the expanders in nodes recursively, appending the result
+// to expanded. Unlike flatten it keeps wrappers, so what they add at render
+// time isn't lost.
+func expandAll(nodes []Node, expanded []Node) []Node {
+	for _, n := range nodes {
+		switch v := n.(type) {
+		case nil:
+		case expander:
+			expanded = expandAll(v.expand(), expanded)
+		default:
+			expanded = append(expanded, n)
+		}
+	}
+	return expanded
+}
+
+// isListItem reports whether n renders as <li> elements only, looking
+// through wrappers
+func isListItem(n Node) bool {
+	if _, ok := n.(wrapper); ok {
+		nodes := flatten([]Node{n})
+		for _, n := range nodes {
+			if !isListItem(n) {
+				return false
+			}
+		}
+		return len(nodes) > 0
+	}
+	e, ok := n.(element)
+	return ok && e.base().name == "li"
+}
@@ -0,0 +1,52 @@
+/**
+ * Copyright 2025 Alexis Bouchez <alexbcz@proton.me>
+ *
+ * This file is part of libhtml.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ */
+
+package html_test
+
+import (
+	"testing"
+
+	. "github.com/alexisbcz/libhtml"
+)
+
+func TestList(t *testing.T) {
+	tests := []struct {
+		node     Node
+		expected string
+	}{
+		{List(Text("a"), Text("b")), `<ul><li>a</li><li>b</li></ul>`},
+		{List(Li(Text("kept")).Class("x"), A(Text("link")).Href("/")).Class("nav"), `<ul class="nav"><li class="x">kept</li><li><a href="/">link</a></li></ul>`},
+		{OrderedList(Text("first"), nil, If(false, Text("skipped")), Group(Text("g1"), Text("g2"))).Start("3"), `<ol start="3"><li>first</li><li>g1</li><li>g2</li></ol>`},
+		{ListOf([]int{1, 2}, func(i int) Node { return Textf("#%d", i) }), `<ul><li>#1</li><li>#2</li></ul>`},
+		{List(), `<ul></ul>`},
+		{List(Keyed("k", Li(Text("a"))), Keyed("t", Text("b"))), `<ul><li data-key="k">a</li><li>b</li></ul>`},
+		{List(WithNonce(Script(), "N")), `<ul><li><script nonce="N"></script></li></ul>`},
+		{List(Keyed("k", Group(Li(Text("a")), Li(Text("b"))))), `<ul><li data-key="k">a</li><li data-key="k">b</li></ul>`},
+	}
+
+	for _, test := range tests {
+		got, err := RenderString(test.node)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if got != test.expected {
+			t.Errorf("expected: \"%s\"; got: \"%s\"", test.expected, got)
+		}
+	}
+}
@@ -0,0 +1,870 @@
+/**
+ * Copyright 2025 Alexis Bouchez <alexbcz@proton.me>
+ *
+ * This file is part of libhtml.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ */
+
+package html
+
+import (
+	"fmt"
+	"html"
+	"slices"
+	"strconv"
+	"strings"
+	"unicode/utf8"
+
+	nethtml "golang.org/x/net/html"
+)
+
+// Markdown converts CommonMark source to a node tree built with the element
+// constructors of this package, so it is escaped and rendered like any other
+// content instead of being dumped as a Raw blob.
+//
+// Supported blocks are ATX and setext headings, paragraphs, bullet and ordered
+// lists (nested by indentation, tight or loose), block quotes, fenced and
+// indented code blocks and thematic breaks. Supported inlines are emphasis,
+// strong emphasis, code spans, links, images, autolinks, backslash escapes,
+// entity references and hard line breaks. Link and image URLs are filtered
+// with SafeURL, and raw HTML, whether a block or tags within a paragraph, is
+// run through Sanitize. Link reference definitions and tables are not
+// supported and render as text.
+//
+// An error is returned if src isn't valid UTF-8.
+func Markdown(src string) (Node, error) {
+	if !utf8.ValidString(src) {
+		return nil, fmt.Errorf("html: markdown source is not valid UTF-8")
+	}
+	src = strings.ReplaceAll(src, "\r\n", "\n")
+	src = strings.ReplaceAll(src, "\r", "\n")
+	lines := strings.Split(src, "\n")
+	fence := ""
+	for i, line := range lines {
+		rest := strings.TrimLeft(line, " \t")
+		switch {
+		case fence == "":
+			// indentation tabs are expanded, but tabs inside fenced code are content
+			lines[i] = expandIndentTabs(line)
+			fence = mdFence(rest)
+		case strings.HasPrefix(rest, fence) && strings.Trim(strings.TrimSpace(rest), fence[:1]) == "":
+			fence = ""
+		}
+	}
+	return Group(mdBlocks(lines, false)...), nil
+}
+
+// expandIndentTabs replaces the tabs of the indentation of line by spaces up
+// to the next multiple of four columns
+func expandIndentTabs(line string) string {
+	if !strings.Contains(line, "\t") {
+		return line
+	}
+	var sb strings.Builder
+	i := 0
+	for ; i < len(line) && (line[i] == ' ' || line[i] == '\t'); i++ {
+		if line[i] == ' ' {
+			sb.WriteByte(' ')
+			continue
+		}
+		sb.WriteString(strings.Repeat(" ", 4-sb.Len()%4))
+	}
+	sb.WriteString(line[i:])
+	return sb.String()
+}
+
+// indentOf returns the number of leading spaces of line
+func indentOf(line string) int {
+	return len(line) - len(strings.TrimLeft(line, " "))
+}
+
+// isBlank reports whether line only holds whitespace
+func isBlank(line string) bool {
+	return strings.TrimSpace(line) == ""
+}
+
+// mdBlocks converts lines to block nodes, tight rendering the paragraphs
+// without a <p> as in the items of a tight list
+func mdBlocks(lines []string, tight bool) []Node {
+	var nodes []Node
+	for i := 0; i < len(lines); {
+		if isBlank(lines[i]) {
+			i++
+			continue
+		}
+		var n Node
+		n, i = mdBlock(lines, i, tight)
+		nodes = append(nodes, n)
+	}
+	return nodes
+}
+
+// mdBlock converts the block starting at lines[i], returning the node and the
+// index of the line following the block
+func mdBlock(lines []string, i int, tight bool) (Node, int) {
+	line := lines[i]
+	indent := indentOf(line)
+	rest := line[indent:]
+
+	switch {
+	case indent >= 4:
+		return mdIndentedCode(lines, i)
+	case mdFence(rest) != "":
+		return mdFencedCode(lines, i)
+	case mdThematicBreak(rest):
+		return Hr(), i + 1
+	case strings.HasPrefix(rest, ">"):
+		return mdBlockquote(lines, i)
+	case mdHTMLBlockStart(rest, false):
+		j := i
+		for j < len(lines) && !isBlank(lines[j]) {
+			j++
+		}
+		return Sanitize(strings.Join(lines[i:j], "\n")), j
+	}
+	if level, text, ok := mdATXHeading(rest); ok {
+		return mdHeading(level, mdInline(text)), i + 1
+	}
+	if m, ok := mdListMarker(line); ok {
+		return mdList(lines, i, m)
+	}
+	return mdParagraph(lines, i, tight)
+}
+
+// mdInterrupts reports whether line starts a block that ends a paragraph
+func mdInterrupts(line string) bool {
+	indent := indentOf(line)
+	if indent >= 4 {
+		return false
+	}
+	rest := line[indent:]
+	if mdFence(rest) != "" || mdThematicBreak(rest) || strings.HasPrefix(rest, ">") || mdHTMLBlockStart(rest, true) {
+		return true
+	}
+	if _, _, ok := mdATXHeading(rest); ok {
+		return true
+	}
+	// only non-empty lists starting at 1 can interrupt a paragraph
+	m, ok := mdListMarker(line)
+	return ok && !isBlank(line[min(m.offset, len(line)):]) && (!m.ordered || m.start == 1)
+}
+
+// mdParagraph converts the paragraph starting at lines[i], which turns into a
+// setext heading when followed by an underline of '=' or '-'
+func mdParagraph(lines []string, i int, tight bool) (Node, int) {
+	var text []string
+	j := i
+	for ; j < len(lines) && !isBlank(lines[j]); j++ {
+		line := lines[j]
+		if j > i && indentOf(line) < 4 {
+			underline := strings.TrimSpace(line)
+			if strings.Trim(underline, "=") == "" {
+				return mdHeading(1, mdInline(strings.Join(text, "\n"))), j + 1
+			}
+			if strings.Trim(underline, "-") == "" {
+				return mdHeading(2, mdInline(strings.Join(text, "\n"))), j + 1
+			}
+			if mdInterrupts(line) {
+				break
+			}
+		}
+		text = append(text, strings.TrimLeft(line, " "))
+	}
+
+	content := mdInline(strings.TrimRight(strings.Join(text, "\n"), " "))
+	if tight {
+		return Group(content...), j
+	}
+	return P(content...), j
+}
+
+// mdHeading creates the heading element of the given level
+func mdHeading(level int, children []Node) Node {
+	switch level {
+	case 1:
+		return H1(children...)
+	case 2:
+		return H2(children...)
+	case 3:
+		return H3(children...)
+	case 4:
+		return H4(children...)
+	case 5:
+		return H5(children...)
+	}
+	return H6(children...)
+}
+
+// mdATXHeading parses a "# Heading" line, without its indentation
+func mdATXHeading(rest string) (int, string, bool) {
+	level := len(rest) - len(strings.TrimLeft(rest, "#"))
+	if level == 0 || level > 6 || (len(rest) > level && rest[level] != ' ') {
+		return 0, "", false
+	}
+	text := strings.TrimSpace(rest[level:])
+	// drop the optional closing sequence of '#'
+	if trimmed := strings.TrimRight(text, "#"); trimmed == "" || strings.HasSuffix(trimmed, " ") {
+		text = strings.TrimSpace(trimmed)
+	}
+	return level, text, true
+}
+
+// mdThematicBreak reports whether rest is a line of three or more '-', '*' or
+// '_' optionally separated by spaces
+func mdThematicBreak(rest string) bool {
+	s := strings.ReplaceAll(rest, " ", "")
+	if len(s) < 3 || (s[0] != '-' && s[0] != '*' && s[0] != '_') {
+		return false
+	}
+	return strings.Trim(s, s[:1]) == ""
+}
+
+// mdTagStart reports whether s starts with a tag, a closing tag or a
+// comment-like construct
+func mdTagStart(s string) bool {
+	if len(s) < 2 || s[0] != '<' {
+		return false
+	}
+	c := s[1]
+	return c == '/' || c == '!' || c == '?' || c >= 'a' && c <= 'z' || c >= 'A' && c <= 'Z'
+}
+
+// mdRawTextTags are the tags starting an HTML block of the first kind of the
+// CommonMark spec, whose content may hold blank lines
+var mdRawTextTags = []string{"pre", "script", "style", "textarea"}
+
+// mdBlockTags are the tags starting an HTML block of the sixth kind of the
+// CommonMark spec
+var mdBlockTags = map[string]bool{
+	"address": true, "article": true, "aside": true, "base": true, "basefont": true, "blockquote": true,
+	"body": true, "caption": true, "center": true, "col": true, "colgroup": true, "dd": true,
+	"details": true, "dialog": true, "dir": true, "div": true, "dl": true, "dt": true,
+	"fieldset": true, "figcaption": true, "figure": true, "footer": true, "form": true, "frame": true,
+	"frameset": true, "h1": true, "h2": true, "h3": true, "h4": true, "h5": true,
+	"h6": true, "head": true, "header": true, "hr": true, "html": true, "iframe": true,
+	"legend": true, "li": true, "link": true, "main": true, "menu": true, "menuitem": true,
+	"nav": true, "noframes": true, "ol": true, "optgroup": true, "option": true, "p": true,
+	"param": true, "search": true, "section": true, "summary": true, "table": true, "tbody": true,
+	"td": true, "tfoot": true, "th": true, "thead": true, "title": true, "tr": true,
+	"track": true, "ul": true,
+}
+
+// mdHTMLBlockStart reports whether rest starts an HTML block following the
+// CommonMark start conditions: a raw text or known block tag, a comment, a
+// processing instruction, a declaration or CDATA, or any other complete tag
+// alone on its line, which can't interrupt a paragraph. Autolinks are never
+// HTML blocks.
+func mdHTMLBlockStart(rest string, inParagraph bool) bool {
+	if len(rest) < 2 || rest[0] != '<' {
+		return false
+	}
+	if _, _, _, ok := mdAutolink(rest, 0); ok {
+		return false
+	}
+	switch {
+	case strings.HasPrefix(rest, "<!--"), strings.HasPrefix(rest, "<?"), strings.HasPrefix(rest, "<![CDATA["):
+		return true
+	case rest[1] == '!':
+		return len(rest) > 2 && (rest[2] >= 'a' && rest[2] <= 'z' || rest[2] >= 'A' && rest[2] <= 'Z')
+	}
+
+	closing := rest[1] == '/'
+	name := rest[1:]
+	if closing {
+		name = rest[2:]
+	}
+	n := 0
+	for n < len(name) && (isASCIIAlnum(name[n]) || n > 0 && name[n] == '-') {
+		n++
+	}
+	if n == 0 || !(name[0] >= 'a' && name[0] <= 'z' || name[0] >= 'A' && name[0] <= 'Z') {
+		return false
+	}
+	tag, after := strings.ToLower(name[:n]), name[n:]
+	ends := after == "" || after[0] == ' ' || after[0] == '\t' || after[0] == '>' || strings.HasPrefix(after, "/>")
+
+	if !closing && slices.Contains(mdRawTextTags, tag) && ends {
+		return true
+	}
+	if mdBlockTags[tag] && ends {
+		return true
+	}
+	if inParagraph || slices.Contains(mdRawTextTags, tag) {
+		return false
+	}
+	return mdCompleteTagLine(rest)
+}
+
+// mdCompleteTagLine reports whether line is a single complete opening or
+// closing tag followed only by whitespace
+func mdCompleteTagLine(line string) bool {
+	z := nethtml.NewTokenizer(strings.NewReader(line))
+	switch z.Next() {
+	case nethtml.StartTagToken, nethtml.EndTagToken, nethtml.SelfClosingTagToken:
+		raw := z.Raw()
+		return raw[len(raw)-1] == '>' && isBlank(line[len(raw):])
+	}
+	return false
+}
+
+// mdFence returns the opening code fence of rest, such as "```", if any
+func mdFence(rest string) string {
+	if rest == "" || (rest[0] != '`' && rest[0] != '~') {
+		return ""
+	}
+	n := len(rest) - len(strings.TrimLeft(rest, rest[:1]))
+	if n < 3 || (rest[0] == '`' && strings.Contains(rest[n:], "`")) {
+		return ""
+	}
+	return rest[:n]
+}
+
+// mdFencedCode converts the fenced code block starting at lines[i]
+func mdFencedCode(lines []string, i int) (Node, int) {
+	indent := indentOf(lines[i])
+	rest := lines[i][indent:]
+	fence := mdFence(rest)
+	info := strings.Fields(mdUnescape(rest[len(fence):]))
+
+	var content strings.Builder
+	j := i + 1
+	for ; j < len(lines); j++ {
+		line := lines[j]
+		if closing := strings.TrimSpace(line); indentOf(line) < 4 && strings.HasPrefix(closing, fence) &&
+			strings.Trim(closing, fence[:1]) == "" {
+			j++
+			break
+		}
+		content.WriteString(line[min(indent, indentOf(line)):])
+		content.WriteString("\n")
+	}
+
+	code := Code(Text(content.String()))
+	if len(info) > 0 {
+		code.Class("language-" + info[0])
+	}
+	return Pre(code), j
+}
+
+// mdIndentedCode converts the indented code block starting at lines[i]
+func mdIndentedCode(lines []string, i int) (Node, int) {
+	j := i
+	for j < len(lines) && (isBlank(lines[j]) || indentOf(lines[j]) >= 4) {
+		j++
+	}
+	for isBlank(lines[j-1]) {
+		j--
+	}
+
+	var content strings.Builder
+	for _, line := range lines[i:j] {
+		content.WriteString(line[min(4, indentOf(line)):])
+		content.WriteString("\n")
+	}
+	return Pre(Code(Text(content.String()))), j
+}
+
+// mdBlockquote converts the block quote starting at lines[i], including the
+// lazy continuation lines of its paragraphs
+func mdBlockquote(lines []string, i int) (Node, int) {
+	var inner []string
+	j := i
+	for ; j < len(lines) && !isBlank(lines[j]); j++ {
+		line := lines[j]
+		indent := indentOf(line)
+		if indent < 4 && strings.HasPrefix(line[indent:], ">") {
+			line = strings.TrimPrefix(line[indent+1:], " ")
+		} else if mdInterrupts(line) {
+			break
+		}
+		inner = append(inner, line)
+	}
+	return Blockquote(mdBlocks(inner, false)...), j
+}
+
+// mdMarker is a parsed list item marker
+type mdMarker struct {
+	ordered bool
+	start   int
+	char    byte
+	offset  int
+}
+
+// mdListMarker parses the list item marker starting line, such as "- " or
+// "1. ", offset being the column the content of the item starts at
+func mdListMarker(line string) (mdMarker, bool) {
+	indent := indentOf(line)
+	rest := line[indent:]
+	if indent >= 4 || rest == "" {
+		return mdMarker{}, false
+	}
+
+	var m mdMarker
+	width := 1
+	switch rest[0] {
+	case '-', '*', '+':
+		m.char = rest[0]
+	default:
+		digits := len(rest) - len(strings.TrimLeft(rest, "0123456789"))
+		if digits == 0 || digits > 9 || digits == len(rest) || (rest[digits] != '.' && rest[digits] != ')') {
+			return mdMarker{}, false
+		}
+		m.ordered, m.char = true, rest[digits]
+		m.start, _ = strconv.Atoi(rest[:digits])
+		width = digits + 1
+	}
+
+	after := rest[width:]
+	spaces := indentOf(after)
+	if after != "" && spaces == 0 {
+		return mdMarker{}, false
+	}
+	if spaces > 4 || spaces == len(after) {
+		// content starting with an indented code block, or an empty item
+		spaces = 1
+	}
+	m.offset = indent + width + spaces
+	return m, true
+}
+
+// mdIsListItem reports whether line starts with a list item marker
+func mdIsListItem(line string) bool {
+	_, ok := mdListMarker(line)
+	return ok
+}
+
+// mdList converts the list starting at lines[i], whose first marker is first
+func mdList(lines []string, i int, first mdMarker) (Node, int) {
+	var items [][]string
+	loose := false
+	blank := false
+	j := i
+	for j < len(lines) {
+		m, ok := mdListMarker(lines[j])
+		if !ok || m.ordered != first.ordered || m.char != first.char || mdThematicBreak(strings.TrimLeft(lines[j], " ")) {
+			break
+		}
+		if blank {
+			loose = true
+		}
+
+		item := []string{""}
+		if len(lines[j]) > m.offset {
+			item[0] = lines[j][m.offset:]
+		}
+	lines:
+		for j++; j < len(lines); j++ {
+			line := lines[j]
+			switch {
+			case isBlank(line):
+				item = append(item, "")
+			case indentOf(line) >= m.offset:
+				item = append(item, line[m.offset:])
+			case mdIsListItem(line):
+				break lines
+			case item[len(item)-1] != "" && !mdInterrupts(line) && !mdThematicBreak(strings.TrimLeft(line, " ")):
+				item = append(item, line)
+			default:
+				break lines
+			}
+		}
+
+		last := len(item)
+		for last > 1 && item[last-1] == "" {
+			last--
+		}
+		blank = last < len(item)
+		if !loose {
+			for _, line := range item[:last] {
+				if line == "" {
+					loose = true
+				}
+			}
+		}
+		items = append(items, item[:last])
+	}
+
+	lis := make([]Node, 0, len(items))
+	for _, item := range items {
+		lis = append(lis, Li(mdBlocks(item, !loose)...))
+	}
+	if first.ordered {
+		return Ol(lis...).StartIf(first.start != 1, strconv.Itoa(first.start)), j
+	}
+	return Ul(lis...), j
+}
+
+// mdInline converts the inline content of a block; when it holds raw HTML the
+// whole content is rendered and sanitized so the tags can't escape the block
+func mdInline(s string) []Node {
+	hasHTML := false
+	nodes := mdSpans(s, &hasHTML)
+	if !hasHTML {
+		return nodes
+	}
+	var sb strings.Builder
+	for _, n := range nodes {
+		n.Render(&sb)
+	}
+	return []Node{Sanitize(sb.String())}
+}
+
+// mdSpans converts inline markdown to nodes, setting hasHTML when raw HTML is
+// found, which is returned as Raw to be sanitized by mdInline
+func mdSpans(s string, hasHTML *bool) []Node {
+	var nodes []Node
+	var text strings.Builder
+	flush := func() {
+		if text.Len() > 0 {
+			nodes = append(nodes, Text(text.String()))
+			text.Reset()
+		}
+	}
+
+	for i := 0; i < len(s); {
+		c := s[i]
+		switch c {
+		case '\\':
+			if i+1 < len(s) && s[i+1] == '\n' {
+				flush()
+				nodes = append(nodes, Br())
+				i += 2
+				continue
+			}
+			if i+1 < len(s) && isASCIIPunct(s[i+1]) {
+				text.WriteByte(s[i+1])
+				i += 2
+				continue
+			}
+		case '\n':
+			before := text.String()
+			trimmed := strings.TrimRight(before, " ")
+			text.Reset()
+			text.WriteString(trimmed)
+			if len(before)-len(trimmed) >= 2 {
+				flush()
+				nodes = append(nodes, Br())
+			} else {
+				text.WriteByte('\n')
+			}
+			for i++; i < len(s) && s[i] == ' '; i++ {
+			}
+			continue
+		case '`':
+			if code, next, ok := mdCodeSpan(s, i); ok {
+				flush()
+				nodes = append(nodes, Code(Text(code)))
+				i = next
+				continue
+			}
+			n := mdRun(s, i)
+			text.WriteString(s[i : i+n])
+			i += n
+			continue
+		case '!':
+			if label, dest, title, next, ok := mdLink(s, i+1); ok {
+				flush()
+				nodes = append(nodes, Img().SafeSrc(dest).Alt(mdPlainText(mdSpans(label, hasHTML))).Title(title))
+				i = next
+				continue
+			}
+		case '[':
+			if label, dest, title, next, ok := mdLink(s, i); ok {
+				flush()
+				nodes = append(nodes, A(mdSpans(label, hasHTML)...).SafeHref(dest).Title(title))
+				i = next
+				continue
+			}
+		case '<':
+			if label, href, next, ok := mdAutolink(s, i); ok {
+				flush()
+				nodes = append(nodes, A(Text(label)).SafeHref(href))
+				i = next
+				continue
+			}
+			if end := strings.IndexByte(s[i:], '>'); end > 0 && mdTagStart(s[i:]) {
+				flush()
+				nodes = append(nodes, Raw(s[i:i+end+1]))
+				*hasHTML = true
+				i += end + 1
+				continue
+			}
+		case '&':
+			if end := strings.IndexByte(s[i:], ';'); end > 1 && end <= 33 {
+				if entity := s[i : i+end+1]; html.UnescapeString(entity) != entity {
+					text.WriteString(html.UnescapeString(entity))
+					i += end + 1
+					continue
+				}
+			}
+		case '*', '_':
+			if n, next, ok := mdEmphasis(s, i, hasHTML); ok {
+				flush()
+				nodes = append(nodes, n)
+				i = next
+				continue
+			}
+			n := mdRun(s, i)
+			text.WriteString(s[i : i+n])
+			i += n
+			continue
+		}
+		text.WriteByte(c)
+		i++
+	}
+	flush()
+	return nodes
+}
+
+// mdRun returns the length of the run of s[i] characters starting at i
+func mdRun(s string, i int) int {
+	n := 1
+	for i+n < len(s) && s[i+n] == s[i] {
+		n++
+	}
+	return n
+}
+
+// isASCIIPunct reports whether b is ASCII punctuation, which can be escaped
+func isASCIIPunct(b byte) bool {
+	return strings.IndexByte("!\"#$%&'()*+,-./:;<=>?@[\\]^_`{|}~", b) >= 0
+}
+
+// mdUnescape removes backslash escapes and decodes entity references
+func mdUnescape(s string) string {
+	var sb strings.Builder
+	for i := 0; i < len(s); i++ {
+		if s[i] == '\\' && i+1 < len(s) && isASCIIPunct(s[i+1]) {
+			i++
+		}
+		sb.WriteByte(s[i])
+	}
+	return html.UnescapeString(sb.String())
+}
+
+// mdCodeSpan parses the code span starting with the backticks at s[i]
+func mdCodeSpan(s string, i int) (string, int, bool) {
+	n := mdRun(s, i)
+	for j := i + n; j < len(s); {
+		if s[j] != '`' {
+			j++
+			continue
+		}
+		m := mdRun(s, j)
+		if m != n {
+			j += m
+			continue
+		}
+		code := strings.ReplaceAll(s[i+n:j], "\n", " ")
+		if len(code) >= 2 && code[0] == ' ' && code[len(code)-1] == ' ' && strings.TrimSpace(code) != "" {
+			code = code[1 : len(code)-1]
+		}
+		return code, j + n, true
+	}
+	return "", 0, false
+}
+
+// mdLink parses a "[label](destination "title")" link starting at s[i]
+func mdLink(s string, i int) (label, dest, title string, next int, ok bool) {
+	if i >= len(s) || s[i] != '[' {
+		return "", "", "", 0, false
+	}
+
+	// find the closing bracket of the label
+	depth, end := 0, -1
+	for j := i; j < len(s) && end < 0; j++ {
+		switch s[j] {
+		case '\\':
+			j++
+		case '[':
+			depth++
+		case ']':
+			depth--
+			if depth == 0 {
+				end = j
+			}
+		}
+	}
+	if end < 0 || end+1 >= len(s) || s[end+1] != '(' {
+		return "", "", "", 0, false
+	}
+	label = s[i+1 : end]
+
+	j := end + 2
+	skipSpace := func() {
+		for j < len(s) && (s[j] == ' ' || s[j] == '\n') {
+			j++
+		}
+	}
+	skipSpace()
+
+	// destination, either <...> or without spaces and with balanced parentheses
+	start := j
+	if j < len(s) && s[j] == '<' {
+		close := strings.IndexAny(s[j:], ">\n")
+		if close < 0 || s[j+close] != '>' {
+			return "", "", "", 0, false
+		}
+		dest, j = s[j+1:j+close], j+close+1
+	} else {
+		parens := 0
+	destination:
+		for ; j < len(s); j++ {
+			switch s[j] {
+			case '\\':
+				j++
+			case '(':
+				parens++
+			case ')':
+				if parens == 0 {
+					break destination
+				}
+				parens--
+			case ' ', '\n':
+				break destination
+			}
+		}
+		dest = s[start:min(j, len(s))]
+	}
+	skipSpace()
+
+	// optional title in double quotes, single quotes or parentheses
+	if j < len(s) && (s[j] == '"' || s[j] == '\'' || s[j] == '(') && j > start {
+		closer := s[j]
+		if closer == '(' {
+			closer = ')'
+		}
+		k := j + 1
+		for ; k < len(s) && s[k] != closer; k++ {
+			if s[k] == '\\' {
+				k++
+			}
+		}
+		if k >= len(s) {
+			return "", "", "", 0, false
+		}
+		title, j = s[j+1:k], k+1
+		skipSpace()
+	}
+
+	if j >= len(s) || s[j] != ')' {
+		return "", "", "", 0, false
+	}
+	return label, mdUnescape(dest), mdUnescape(title), j + 1, true
+}
+
+// mdAutolink parses a "<https://example.com>" or "<me@example.com>" autolink
+func mdAutolink(s string, i int) (label, href string, next int, ok bool) {
+	end := strings.IndexByte(s[i:], '>')
+	if end < 0 {
+		return "", "", 0, false
+	}
+	inner := s[i+1 : i+end]
+	if inner == "" || strings.ContainsAny(inner, " \n<") {
+		return "", "", 0, false
+	}
+	if scheme, _, found := strings.Cut(inner, ":"); found && len(scheme) >= 2 && len(scheme) <= 32 && isASCIIAlnum(scheme[0]) {
+		for k := 0; k < len(scheme); k++ {
+			if !isASCIIAlnum(scheme[k]) && scheme[k] != '+' && scheme[k] != '.' && scheme[k] != '-' {
+				return "", "", 0, false
+			}
+		}
+		return inner, inner, i + end + 1, true
+	}
+	if local, domain, found := strings.Cut(inner, "@"); found && local != "" && strings.Contains(domain, ".") {
+		return inner, "mailto:" + inner, i + end + 1, true
+	}
+	return "", "", 0, false
+}
+
+// mdEmphasis parses the emphasis or strong emphasis opened by the run of '*'
+// or '_' at s[i]
+func mdEmphasis(s string, i int, hasHTML *bool) (Node, int, bool) {
+	c := s[i]
+	run := mdRun(s, i)
+	if i+run >= len(s) || s[i+run] == ' ' || s[i+run] == '\n' {
+		return nil, 0, false
+	}
+	if c == '_' && i > 0 && isASCIIAlnum(s[i-1]) {
+		return nil, 0, false
+	}
+
+	for n := min(run, 3); n > 0; n-- {
+		j := mdCloser(s, i+n, c, n)
+		if j < 0 {
+			continue
+		}
+		inner := mdSpans(s[i+n:j], hasHTML)
+		var node Node
+		switch n {
+		case 3:
+			node = Em(Strong(inner...))
+		case 2:
+			node = Strong(inner...)
+		default:
+			node = Em(inner...)
+		}
+		// the unmatched part of the opening run stays literal
+		if run > n {
+			node = Group(Text(s[i:i+run-n]), node)
+		}
+		return node, j + n, true
+	}
+	return nil, 0, false
+}
+
+// mdCloser returns the index of the run of exactly n c characters closing an
+// emphasis opened before from, skipping escapes and code spans, or -1
+func mdCloser(s string, from int, c byte, n int) int {
+	for k := from; k < len(s); {
+		switch s[k] {
+		case '\\':
+			k += 2
+			continue
+		case '`':
+			if _, next, ok := mdCodeSpan(s, k); ok {
+				k = next
+				continue
+			}
+		case c:
+			m := mdRun(s, k)
+			closes := m == n && s[k-1] != ' ' && s[k-1] != '\n'
+			if c == '_' && k+m < len(s) && isASCIIAlnum(s[k+m]) {
+				closes = false
+			}
+			if closes && k > from {
+				return k
+			}
+			k += m
+			continue
+		}
+		k++
+	}
+	return -1
+}
+
+// mdPlainText returns the text content of nodes, used for image alt text
+func mdPlainText(nodes []Node) string {
+	var sb strings.Builder
+	for _, n := range flatten(nodes) {
+		switch v := n.(type) {
+		case *text:
+			sb.WriteString(v.content)
+		case element:
+			sb.WriteString(mdPlainText(v.base().children))
+		}
+	}
+	return sb.String()
+}
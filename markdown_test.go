@@ -0,0 +1,77 @@
+/**
+ * Copyright 2025 Alexis Bouchez <alexbcz@proton.me>
+ *
+ * This file is part of libhtml.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ */
+
+package html_test
+
+import (
+	"testing"
+
+	. "github.com/alexisbcz/libhtml"
+)
+
+func TestMarkdown(t *testing.T) {
+	tests := []struct {
+		input    string
+		expected string
+	}{
+		{"# Title\n\nSome *emphasis*, **strong** and `a < b`.", `<h1>Title</h1><p>Some <em>emphasis</em>, <strong>strong</strong> and <code>a &lt; b</code>.</p>`},
+		{"Setext\n===\n\nSub ##\n---", `<h1>Setext</h1><h2>Sub ##</h2>`},
+		{"### Closed ###", `<h3>Closed</h3>`},
+		{"line one\nline two  \nline three", "<p>line one\nline two<br/>line three</p>"},
+		{"- a\n- b\n  - nested\n- c", `<ul><li>a</li><li>b<ul><li>nested</li></ul></li><li>c</li></ul>`},
+		{"1. one\n\n2. two", `<ol><li><p>one</p></li><li><p>two</p></li></ol>`},
+		{"3) three\n4) four", `<ol start="3"><li>three</li><li>four</li></ol>`},
+		{"> quoted\ncontinued\n\n> - item", `<blockquote><p>quoted` + "\n" + `continued</p></blockquote><blockquote><ul><li>item</li></ul></blockquote>`},
+		{"```go\nfunc main() {\n\tfmt.Println(\"<hi>\")\n}\n```", "<pre><code class=\"language-go\">func main() {\n\tfmt.Println(&#34;&lt;hi&gt;&#34;)\n}\n</code></pre>"},
+		{"    indented\n    code", "<pre><code>indented\ncode\n</code></pre>"},
+		{"a\n\n***\n\nb", `<p>a</p><hr/><p>b</p>`},
+		{`[site](https://example.com "Home") and ![logo](/logo.png)`, `<p><a href="https://example.com" title="Home">site</a> and <img alt="logo" src="/logo.png"/></p>`},
+		{`[bad](javascript:alert(1)) <https://go.dev> <me@example.com>`, `<p><a href="about:blank">bad</a> <a href="https://go.dev">https://go.dev</a> <a href="mailto:me@example.com">me@example.com</a></p>`},
+		{`\*not emphasis\* &amp; &copy; snake_case_name`, `<p>*not emphasis* &amp; © snake_case_name</p>`},
+		{"***both*** and **nested *em* inside**", `<p><em><strong>both</strong></em> and <strong>nested <em>em</em> inside</strong></p>`},
+		{"Hello <b onclick=\"x()\">bold</b> *md*", `<p>Hello <b>bold</b> <em>md</em></p>`},
+		{"<div>\n<script>alert(1)</script>\n</div>\n\ntext", `<div>` + "\n\n" + `</div><p>text</p>`},
+		{"* * *\n- x", `<hr/><ul><li>x</li></ul>`},
+		{"<https://example.com> is my site", `<p><a href="https://example.com">https://example.com</a> is my site</p>`},
+		{"<b>bold</b> text", `<p><b>bold</b> text</p>`},
+		{"<span>\nalone\n\nafter", "<span>\nalone</span><p>after</p>"},
+		{"para\n<span>\nmore", "<p>para\n<span>\nmore</span></p>"},
+		{"para\n<div>block</div>", "<p>para</p><div>block</div>"},
+	}
+
+	for _, test := range tests {
+		n, err := Markdown(test.input)
+		if err != nil {
+			t.Fatal(err)
+		}
+		got, err := RenderString(n)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if got != test.expected {
+			t.Errorf("input %q\nexpected: %q\ngot:      %q", test.input, test.expected, got)
+		}
+	}
+}
+
+func TestMarkdownInvalidUTF8(t *testing.T) {
+	if _, err := Markdown("bad \xff"); err == nil {
+		t.Error("expected an error for invalid UTF-8")
+	}
+}
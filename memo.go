@@ -0,0 +1,75 @@
+/**
+ * Copyright 2025 Alexis Bouchez <alexbcz@proton.me>
+ *
+ * This file is part of libhtml.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ */
+
+package html
+
+import (
+	"context"
+	"io"
+	"sync"
+)
+
+// memo renders its child once and replays the cached bytes afterwards
+type memo struct {
+	node Node
+	once sync.Once
+	b    []byte
+	err  error
+}
+
+// Memo creates a node that renders n on first use and then writes the cached
+// bytes on every following render, which suits static subtrees like a site
+// header or footer shared across requests. The wrapped node must be effectively
+// immutable: later changes to it are not reflected. A render error is cached too.
+// The cache holds the default rendering, so when the context carries a nonce
+// or a render mode, set by WithNonce, WithMode or RenderOptions, the node is
+// rendered afresh with it instead. Memo is safe for concurrent use.
+func Memo(n Node) Node {
+	return &memo{node: n}
+}
+
+// Render implements Node.Render for memo
+func (m *memo) Render(w io.Writer) error {
+	_, err := m.WriteTo(w)
+	return err
+}
+
+// RenderContext implements ContextNode for memo
+// The cached bytes are only written when ctx changes nothing in the output
+func (m *memo) RenderContext(ctx context.Context, w io.Writer) error {
+	if _, ok := ctx.Value(nonceKey{}).(string); ok || modeFrom(ctx) != DefaultMode {
+		if m.node == nil {
+			return nil
+		}
+		return RenderContext(ctx, m.node, w)
+	}
+	return m.Render(w)
+}
+
+// WriteTo implements io.WriterTo for memo
+func (m *memo) WriteTo(w io.Writer) (int64, error) {
+	m.once.Do(func() {
+		m.b, m.err = RenderBytes(m.node)
+	})
+	if m.err != nil {
+		return 0, m.err
+	}
+	n, err := w.Write(m.b)
+	return int64(n), err
+}
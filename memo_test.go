@@ -0,0 +1,110 @@
+/**
+ * Copyright 2025 Alexis Bouchez <alexbcz@proton.me>
+ *
+ * This file is part of libhtml.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ */
+
+package html_test
+
+import (
+	"io"
+	"strings"
+	"sync"
+	"testing"
+
+	. "github.com/alexisbcz/libhtml"
+)
+
+func staticNav() Node {
+	links := []string{"Home", "Blog", "Projects", "About", "Contact"}
+	return Nav(Ul(Map(links, func(label string) Node {
+		return Li(A(Text(label)).Href("/" + label).Class("nav-link"))
+	}))).Class("site-nav")
+}
+
+func TestMemo(t *testing.T) {
+	renders := 0
+	m := Memo(IfFunc(true, func() Node {
+		renders++
+		return P(Text("cached"))
+	}))
+
+	var wg sync.WaitGroup
+	for range 10 {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			if got := MustRenderString(m); got != "<p>cached</p>" {
+				t.Errorf("expected: \"<p>cached</p>\"; got: \"%s\"", got)
+			}
+		}()
+	}
+	wg.Wait()
+
+	if renders != 1 {
+		t.Errorf("expected the child to render once; got: %d", renders)
+	}
+}
+
+func TestMemoContext(t *testing.T) {
+	m := Memo(Div(Br(), Script(Raw("run()"))))
+	if got, expected := MustRenderString(m), "<div><br/><script>run()</script></div>"; got != expected {
+		t.Errorf("expected: \"%s\"; got: \"%s\"", expected, got)
+	}
+
+	tests := []struct {
+		node     Node
+		expected string
+	}{
+		{WithNonce(m, "abc"), `<div><br/><script nonce="abc">run()</script></div>`},
+		{WithMode(m, HTML5), `<div><br><script>run()</script></div>`},
+		{m, "<div><br/><script>run()</script></div>"},
+	}
+
+	for _, test := range tests {
+		if got := MustRenderString(test.node); got != test.expected {
+			t.Errorf("expected: \"%s\"; got: \"%s\"", test.expected, got)
+		}
+	}
+
+	sb := &strings.Builder{}
+	if err := RenderWith(m, sb, RenderOptions{Nonce: "n0", Mode: XHTML}); err != nil {
+		t.Fatal(err)
+	}
+	if got, expected := sb.String(), `<div><br /><script nonce="n0">run()</script></div>`; got != expected {
+		t.Errorf("expected: \"%s\"; got: \"%s\"", expected, got)
+	}
+}
+
+func BenchmarkStaticNav(b *testing.B) {
+	nav := staticNav()
+	b.ReportAllocs()
+	for b.Loop() {
+		if err := nav.Render(io.Discard); err != nil {
+			b.Fatal(err)
+		}
+	}
+}
+
+func BenchmarkMemoStaticNav(b *testing.B) {
+	nav := Memo(staticNav())
+	b.ReportAllocs()
+	for b.Loop() {
+		if err := nav.Render(io.Discard); err != nil {
+			b.Fatal(err)
+		}
+	}
+}
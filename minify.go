@@ -0,0 +1,140 @@
+/**
+ * Copyright 2025 Alexis Bouchez <alexbcz@proton.me>
+ *
+ * This file is part of libhtml.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ */
+
+package html
+
+import (
+	"bytes"
+	"context"
+	"io"
+
+	nethtml "golang.org/x/net/html"
+)
+
+// RenderMinified renders n with insignificant whitespace removed, covering the
+// whitespace of text nodes as well as of Raw content. The rules are:
+//
+//   - content of <pre>, <textarea>, <script> and <style> is written verbatim
+//   - elsewhere every run of spaces, tabs, carriage returns, line feeds and form
+//     feeds in text collapses to a single space
+//   - that space is removed entirely at the start or end of the output and next
+//     to the opening or closing tag of a block element such as <div>, <p> or
+//     <li>, the same elements RenderIndent puts on their own line
+//   - whitespace next to inline elements such as <span> or <a> is kept as a
+//     single space, as it is visible in the rendered page
+//   - tags, comments and the doctype are written as rendered
+func RenderMinified(n Node, w io.Writer) error {
+	return renderMinified(context.Background(), n, w)
+}
+
+// renderMinified renders n like RenderMinified with ctx
+func renderMinified(ctx context.Context, n Node, w io.Writer) error {
+	src := getBuffer()
+	defer putBuffer(src)
+	if n != nil {
+		if err := RenderContext(ctx, n, src); err != nil {
+			return err
+		}
+	}
+
+	out := getBuffer()
+	defer putBuffer(out)
+	if err := minify(out, src.Bytes()); err != nil {
+		return err
+	}
+	_, err := w.Write(out.Bytes())
+	return err
+}
+
+// minify writes the markup in src to out following the RenderMinified rules
+func minify(out *bytes.Buffer, src []byte) error {
+	z := nethtml.NewTokenizer(bytes.NewReader(src))
+	var text []byte
+	afterBlock := true
+	verbatim := 0
+
+	// flush writes the pending text, trimmed on the side of block boundaries
+	flush := func(beforeBlock bool) {
+		text = collapseSpace(text)
+		if afterBlock {
+			text = bytes.TrimPrefix(text, []byte(" "))
+		}
+		if beforeBlock {
+			text = bytes.TrimSuffix(text, []byte(" "))
+		}
+		out.Write(text)
+		text = text[:0]
+	}
+
+	for {
+		tt := z.Next()
+		switch tt {
+		case nethtml.ErrorToken:
+			if err := z.Err(); err != io.EOF {
+				return err
+			}
+			flush(true)
+			return nil
+		case nethtml.TextToken:
+			if verbatim == 0 {
+				text = append(text, z.Raw()...)
+				continue
+			}
+			out.Write(z.Raw())
+			continue
+		}
+
+		raw := z.Raw()
+		block := tt == nethtml.DoctypeToken
+		if tt == nethtml.StartTagToken || tt == nethtml.EndTagToken || tt == nethtml.SelfClosingTagToken {
+			name, _ := z.TagName()
+			block = blockElements[string(name)]
+			switch {
+			case tt == nethtml.StartTagToken && verbatimElements[string(name)]:
+				verbatim++
+			case tt == nethtml.EndTagToken && verbatimElements[string(name)] && verbatim > 0:
+				verbatim--
+			}
+		}
+		flush(block)
+		out.Write(raw)
+		if tt != nethtml.CommentToken {
+			afterBlock = block
+		}
+	}
+}
+
+// collapseSpace replaces every run of HTML whitespace in b with a single space
+func collapseSpace(b []byte) []byte {
+	collapsed := b[:0]
+	space := false
+	for _, c := range b {
+		switch c {
+		case ' ', '\t', '\n', '\r', '\f':
+			if !space {
+				collapsed = append(collapsed, ' ')
+			}
+			space = true
+		default:
+			collapsed = append(collapsed, c)
+			space = false
+		}
+	}
+	return collapsed
+}
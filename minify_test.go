@@ -0,0 +1,57 @@
+/**
+ * Copyright 2025 Alexis Bouchez <alexbcz@proton.me>
+ *
+ * This file is part of libhtml.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ */
+
+package html_test
+
+import (
+	"strings"
+	"testing"
+
+	. "github.com/alexisbcz/libhtml"
+)
+
+func TestRenderMinified(t *testing.T) {
+	tests := []struct {
+		node     Node
+		expected string
+	}{
+		{P(Text("  hello \n\t  world  ")), `<p>hello world</p>`},
+		{P(Text("a "), Strong(Text(" b ")), Text("  c")), `<p>a <strong> b </strong> c</p>`},
+		{Div(Raw("\n  <ul>\n    <li> one </li>\n    <li>two</li>\n  </ul>\n")), `<div><ul><li>one</li><li>two</li></ul></div>`},
+		{Pre(Text("  keep\n    this  ")), "<pre>  keep\n    this  </pre>"},
+		{Textarea(Text(" a\n b ")), "<textarea> a\n b </textarea>"},
+		{Script(Raw("if (a)  {\n  b()\n}")), "<script>if (a)  {\n  b()\n}</script>"},
+		{Div(Raw("<pre> x\n</pre>  \n <p> y </p>")), "<div><pre> x\n</pre><p>y</p></div>"},
+		{Group(Comment(" c "), Text("  x  ")), `<!-- c -->x`},
+		{
+			Document(HTML(Head(Title(Text("  My   page "))), Body(Text("\n"), P(Text("Hi")), Text("\n")))),
+			`<!DOCTYPE html><html><head><title>My page</title></head><body><p>Hi</p></body></html>`,
+		},
+	}
+
+	for _, test := range tests {
+		var sb strings.Builder
+		if err := RenderMinified(test.node, &sb); err != nil {
+			t.Fatal(err)
+		}
+		if sb.String() != test.expected {
+			t.Errorf("expected: %q; got: %q", test.expected, sb.String())
+		}
+	}
+}
@@ -0,0 +1,102 @@
+/**
+ * Copyright 2025 Alexis Bouchez <alexbcz@proton.me>
+ *
+ * This file is part of libhtml.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ */
+
+package html
+
+import (
+	"context"
+	"io"
+)
+
+// RenderMode selects the syntax used for void elements and boolean attributes
+type RenderMode int
+
+const (
+	// DefaultMode renders void elements as <br/> and boolean attributes as a
+	// bare name, as in <details open>
+	DefaultMode RenderMode = iota
+
+	// HTML5 renders void elements as <br>, without a slash, and boolean
+	// attributes as a bare name
+	HTML5
+
+	// XHTML renders void elements as <br /> and boolean attributes with their
+	// name as value, as in open="open", for XML toolchains
+	XHTML
+
+	// Polyglot renders markup that parses the same as HTML and XML, with
+	// void elements as <br/> and boolean attributes as open="open"
+	Polyglot
+)
+
+// voidEnd returns how the opening tag of a void element ends
+func (m RenderMode) voidEnd() string {
+	switch m {
+	case HTML5:
+		return ">"
+	case XHTML:
+		return " />"
+	}
+	return "/>"
+}
+
+// minimize reports whether boolean attributes are written as a bare name
+func (m RenderMode) minimize() bool {
+	return m == DefaultMode || m == HTML5
+}
+
+// modeKey is the context key holding the mode set by WithMode
+type modeKey struct{}
+
+// modeFrom returns the render mode set in ctx, DefaultMode when none is
+func modeFrom(ctx context.Context) RenderMode {
+	mode, _ := ctx.Value(modeKey{}).(RenderMode)
+	return mode
+}
+
+// withMode renders its child in a given render mode
+type withMode struct {
+	node Node
+	mode RenderMode
+}
+
+// WithMode creates a node that renders n in the given mode, e.g.
+// WithMode(page, HTML5) for <br> instead of <br/>. It applies to the elements
+// rendered through RenderContext, including nested ContextNode children.
+func WithMode(n Node, mode RenderMode) Node {
+	return &withMode{node: n, mode: mode}
+}
+
+// Render implements Node.Render for withMode
+func (wm *withMode) Render(w io.Writer) error {
+	return wm.RenderContext(context.Background(), w)
+}
+
+// RenderContext implements ContextNode for withMode
+func (wm *withMode) RenderContext(ctx context.Context, w io.Writer) error {
+	if wm.node == nil {
+		return nil
+	}
+	return RenderContext(context.WithValue(ctx, modeKey{}, wm.mode), wm.node, w)
+}
+
+// unwrap implements wrapper for withMode
+func (wm *withMode) unwrap() Node {
+	return wm.node
+}
@@ -0,0 +1,55 @@
+/**
+ * Copyright 2025 Alexis Bouchez <alexbcz@proton.me>
+ *
+ * This file is part of libhtml.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ */
+
+package html_test
+
+import (
+	"testing"
+
+	. "github.com/alexisbcz/libhtml"
+)
+
+func TestWithMode(t *testing.T) {
+	form := Form(
+		Input().Type("checkbox").BooleanAttribute("checked"),
+		Br(),
+		Details(Summary(Text("More"))).Open(),
+	)
+	tests := []struct {
+		mode     RenderMode
+		expected string
+	}{
+		{DefaultMode, `<form><input checked type="checkbox"/><br/><details open><summary>More</summary></details></form>`},
+		{HTML5, `<form><input checked type="checkbox"><br><details open><summary>More</summary></details></form>`},
+		{XHTML, `<form><input checked="checked" type="checkbox" /><br /><details open="open"><summary>More</summary></details></form>`},
+		{Polyglot, `<form><input checked="checked" type="checkbox"/><br/><details open="open"><summary>More</summary></details></form>`},
+	}
+
+	for _, test := range tests {
+		if got := MustRenderString(WithMode(form, test.mode)); got != test.expected {
+			t.Errorf("mode %d: expected: \"%s\"; got: \"%s\"", test.mode, test.expected, got)
+		}
+	}
+
+	// The mode reaches the elements below lazy and keyed nodes as well
+	lazy := Div(Map([]string{"a"}, func(s string) Node { return Keyed(s, Hr()) }))
+	if got, expected := MustRenderString(WithMode(lazy, HTML5)), `<div><hr data-key="a"></div>`; got != expected {
+		t.Errorf("expected: \"%s\"; got: \"%s\"", expected, got)
+	}
+}
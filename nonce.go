@@ -0,0 +1,61 @@
+/**
+ * Copyright 2025 Alexis Bouchez <alexbcz@proton.me>
+ *
+ * This file is part of libhtml.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ */
+
+package html
+
+import (
+	"context"
+	"io"
+)
+
+// nonceKey is the context key holding the nonce stamped by WithNonce
+type nonceKey struct{}
+
+// withNonce renders its child with a Content-Security-Policy nonce
+type withNonce struct {
+	node  Node
+	nonce string
+}
+
+// WithNonce creates a node that renders n with the "nonce" attribute set to
+// nonce on every <script> and <style> element, overriding their own nonce.
+// The tree itself isn't modified, so a shared tree can be stamped with a fresh
+// nonce per response. Custom nodes only receive the nonce if they implement
+// ContextNode and render their children with RenderContext.
+func WithNonce(n Node, nonce string) Node {
+	return &withNonce{node: n, nonce: nonce}
+}
+
+// Render implements Node.Render for withNonce
+func (wn *withNonce) Render(w io.Writer) error {
+	return wn.RenderContext(context.Background(), w)
+}
+
+// RenderContext implements ContextNode for withNonce
+func (wn *withNonce) RenderContext(ctx context.Context, w io.Writer) error {
+	if wn.node == nil {
+		return nil
+	}
+	return RenderContext(context.WithValue(ctx, nonceKey{}, wn.nonce), wn.node, w)
+}
+
+// unwrap implements wrapper for withNonce
+func (wn *withNonce) unwrap() Node {
+	return wn.node
+}
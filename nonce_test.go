@@ -0,0 +1,63 @@
+/**
+ * Copyright 2025 Alexis Bouchez <alexbcz@proton.me>
+ *
+ * This file is part of libhtml.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ */
+
+package html_test
+
+import (
+	"testing"
+
+	. "github.com/alexisbcz/libhtml"
+)
+
+func TestNonce(t *testing.T) {
+	tests := []struct {
+		node     Node
+		expected string
+	}{
+		{Script(Raw("init()")).Nonce("abc123"), `<script nonce="abc123">init()</script>`},
+		{Style(Raw("p{}")).NonceIf(false, "abc123"), `<style>p{}</style>`},
+		{
+			WithNonce(Group(
+				Head(Style(Raw("p{}")), Script().Src("/app.js").Nonce("old")),
+				Body(Div(Script(Raw("run()"))).Class("x")),
+			), `r4nd"m`),
+			`<head><style nonce="r4nd&#34;m">p{}</style><script nonce="r4nd&#34;m" src="/app.js"></script></head><body><div class="x"><script nonce="r4nd&#34;m">run()</script></div></body>`,
+		},
+	}
+
+	for _, test := range tests {
+		got, err := RenderString(test.node)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if got != test.expected {
+			t.Errorf("expected: \"%s\"; got: \"%s\"", test.expected, got)
+		}
+	}
+}
+
+func TestWithNonceDoesNotModifyTree(t *testing.T) {
+	tree := Script(Raw("x()"))
+	if _, err := RenderString(WithNonce(tree, "n1")); err != nil {
+		t.Fatal(err)
+	}
+	if got := MustRenderString(tree); got != `<script>x()</script>` {
+		t.Errorf("expected the tree to be left untouched; got: \"%s\"", got)
+	}
+}
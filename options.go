@@ -0,0 +1,104 @@
+/**
+ * Copyright 2025 Alexis Bouchez <alexbcz@proton.me>
+ *
+ * This file is part of libhtml.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ */
+
+package html
+
+import (
+	"context"
+	"errors"
+	"io"
+)
+
+// RenderOptions configures RenderWith, the zero value renders exactly like
+// Render does. New options are always added as fields whose zero value keeps
+// the current output, so setting options by name never breaks.
+type RenderOptions struct {
+	// Context is passed to every ContextNode, context.Background when nil
+	Context context.Context
+
+	// Indent renders with newlines and this indentation like RenderIndent,
+	// when not empty
+	Indent string
+
+	// Minify removes insignificant whitespace like RenderMinified, it can't be
+	// combined with Indent
+	Minify bool
+
+	// Mode selects the syntax of void elements and boolean attributes like
+	// WithMode does
+	Mode RenderMode
+
+	// Nonce stamps a Content-Security-Policy nonce on every <script> and
+	// <style> element like WithNonce does, when not empty
+	Nonce string
+
+	// TrailingNewline ends the output with a newline, as POSIX text files do
+	TrailingNewline bool
+
+	// EmitBOM starts the output with a UTF-8 byte order mark
+	EmitBOM bool
+}
+
+// utf8BOM is the UTF-8 encoded byte order mark
+const utf8BOM = "\uFEFF"
+
+// RenderWith renders n into w with the given options
+// Render, RenderString and RenderBytes are RenderWith with the zero options.
+func RenderWith(n Node, w io.Writer, opts RenderOptions) error {
+	if opts.Indent != "" && opts.Minify {
+		return errors.New("html: the Indent and Minify render options can't be combined")
+	}
+
+	ctx := opts.Context
+	if ctx == nil {
+		ctx = context.Background()
+	}
+	if opts.Nonce != "" {
+		ctx = context.WithValue(ctx, nonceKey{}, opts.Nonce)
+	}
+	if opts.Mode != DefaultMode {
+		ctx = context.WithValue(ctx, modeKey{}, opts.Mode)
+	}
+
+	if opts.EmitBOM {
+		if _, err := io.WriteString(w, utf8BOM); err != nil {
+			return err
+		}
+	}
+	if n != nil {
+		var err error
+		switch {
+		case opts.Indent != "":
+			err = renderIndent(ctx, n, w, opts.Indent)
+		case opts.Minify:
+			err = renderMinified(ctx, n, w)
+		default:
+			err = RenderContext(ctx, n, w)
+		}
+		if err != nil {
+			return err
+		}
+	}
+	if opts.TrailingNewline {
+		if _, err := io.WriteString(w, "\n"); err != nil {
+			return err
+		}
+	}
+	return nil
+}
@@ -0,0 +1,120 @@
+/**
+ * Copyright 2025 Alexis Bouchez <alexbcz@proton.me>
+ *
+ * This file is part of libhtml.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ */
+
+package html_test
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"testing"
+
+	. "github.com/alexisbcz/libhtml"
+)
+
+func TestRenderWith(t *testing.T) {
+	page := Document(HTML(Body(P(Text("Hi")))))
+	plain := MustRenderString(page)
+
+	tests := []struct {
+		opts     RenderOptions
+		expected string
+	}{
+		{RenderOptions{}, plain},
+		{RenderOptions{TrailingNewline: true}, plain + "\n"},
+		{RenderOptions{EmitBOM: true}, "\uFEFF" + plain},
+		{RenderOptions{TrailingNewline: true, EmitBOM: true}, "\uFEFF" + plain + "\n"},
+	}
+
+	for _, test := range tests {
+		var sb strings.Builder
+		if err := RenderWith(page, &sb, test.opts); err != nil {
+			t.Fatalf("%+v: %v", test.opts, err)
+		}
+		if got := sb.String(); got != test.expected {
+			t.Errorf("%+v: expected: %q; got: %q", test.opts, test.expected, got)
+		}
+	}
+}
+
+func TestRenderWithOptions(t *testing.T) {
+	page := Div(P(Text("Hi"), Br()), Script(Text("run()")))
+
+	tests := []struct {
+		opts     RenderOptions
+		expected string
+	}{
+		{RenderOptions{Mode: HTML5}, "<div><p>Hi<br></p><script>run()</script></div>"},
+		{RenderOptions{Nonce: "n0"}, `<div><p>Hi<br/></p><script nonce="n0">run()</script></div>`},
+		{RenderOptions{Minify: true, Mode: XHTML}, "<div><p>Hi<br /></p><script>run()</script></div>"},
+		{
+			RenderOptions{Indent: "  ", Nonce: "n0", Mode: HTML5, TrailingNewline: true},
+			"<div>\n  <p>Hi<br></p>\n  <script nonce=\"n0\">run()</script>\n</div>\n",
+		},
+		{
+			RenderOptions{Indent: "  "},
+			"<div>\n  <p>Hi<br/></p>\n  <script>run()</script>\n</div>",
+		},
+	}
+
+	for _, test := range tests {
+		var sb strings.Builder
+		if err := RenderWith(page, &sb, test.opts); err != nil {
+			t.Fatalf("%+v: %v", test.opts, err)
+		}
+		if got := sb.String(); got != test.expected {
+			t.Errorf("%+v: expected: %q; got: %q", test.opts, test.expected, got)
+		}
+	}
+}
+
+func TestRenderWithIndentWrappers(t *testing.T) {
+	page := Div(WithNonce(Script(Text("a()")), "inner"), WithMode(P(Br()), HTML5))
+	var sb strings.Builder
+	if err := RenderWith(page, &sb, RenderOptions{Indent: "\t"}); err != nil {
+		t.Fatal(err)
+	}
+	expected := "<div>\n\t<script nonce=\"inner\">a()</script>\n\t<p><br></p>\n</div>"
+	if got := sb.String(); got != expected {
+		t.Errorf("expected: %q; got: %q", expected, got)
+	}
+
+	list := Ul(Keyed("a", Li(Text("one"))), Keyed("b", Li(P(Text("two")))), Keyed("c", Span()))
+	sb.Reset()
+	if err := RenderWith(list, &sb, RenderOptions{Indent: "\t"}); err != nil {
+		t.Fatal(err)
+	}
+	expected = "<ul>\n\t<li data-key=\"a\">one</li>\n\t<li data-key=\"b\">\n\t\t<p>two</p>\n\t</li>\n\t<span data-key=\"c\"></span>\n</ul>"
+	if got := sb.String(); got != expected {
+		t.Errorf("expected: %q; got: %q", expected, got)
+	}
+}
+
+func TestRenderWithContext(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	n := Async(func(ctx context.Context) (Node, error) { return Text("never"), nil })
+	if err := RenderWith(Div(n), new(strings.Builder), RenderOptions{Context: ctx}); !errors.Is(err, context.Canceled) {
+		t.Errorf("expected context.Canceled; got: %v", err)
+	}
+
+	if err := RenderWith(Div(), new(strings.Builder), RenderOptions{Indent: " ", Minify: true}); err == nil {
+		t.Error("expected an error when combining Indent and Minify")
+	}
+}
@@ -0,0 +1,73 @@
+/**
+ * Copyright 2025 Alexis Bouchez <alexbcz@proton.me>
+ *
+ * This file is part of libhtml.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ */
+
+package html
+
+import "strconv"
+
+// Pagination creates the navigation of a paginated list with total pages,
+// current being the one shown and hrefFor returning the link of a page. It
+// renders a <nav> holding a list with the previous link, the first and last
+// pages, the pages around the current one with an ellipsis for the gaps, and
+// the next link. The current page is marked with aria-current="page", and the
+// previous and next items on the first and last pages are rendered disabled,
+// as spans with aria-disabled="true". Nothing is rendered for a single page.
+func Pagination(current, total int, hrefFor func(page int) string) Node {
+	if total <= 1 {
+		return Group()
+	}
+	current = min(max(current, 1), total)
+
+	items := []Node{pageLink("Previous", "prev", current-1, current > 1, hrefFor)}
+	last := 0
+	for _, page := range []int{1, current - 1, current, current + 1, total} {
+		if page <= last || page < 1 || page > total {
+			continue
+		}
+		switch {
+		case page-last == 2:
+			items = append(items, pageNumber(last+1, current, hrefFor))
+		case page-last > 2:
+			items = append(items, Span(Text("…")).Attribute("aria-hidden", "true"))
+		}
+		items = append(items, pageNumber(page, current, hrefFor))
+		last = page
+	}
+	items = append(items, pageLink("Next", "next", current+1, current < total, hrefFor))
+
+	nav := Nav(List(items...))
+	nav.Attribute("aria-label", "Pagination")
+	return nav
+}
+
+// pageNumber creates the link of a page, marked when it is the current one
+func pageNumber(page, current int, hrefFor func(page int) string) Node {
+	link := A(Text(strconv.Itoa(page))).Href(hrefFor(page))
+	link.AttributeIf(page == current, "aria-current", "page")
+	return link
+}
+
+// pageLink creates the previous or next link to page, or a disabled
+// placeholder when there is no such page
+func pageLink(label, rel string, page int, enabled bool, hrefFor func(page int) string) Node {
+	if !enabled {
+		return Span(Text(label)).Attribute("aria-disabled", "true")
+	}
+	return A(Text(label)).Href(hrefFor(page)).Rel(rel)
+}
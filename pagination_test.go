@@ -0,0 +1,90 @@
+/**
+ * Copyright 2025 Alexis Bouchez <alexbcz@proton.me>
+ *
+ * This file is part of libhtml.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ */
+
+package html_test
+
+import (
+	"strconv"
+	"strings"
+	"testing"
+
+	. "github.com/alexisbcz/libhtml"
+)
+
+func pageHref(page int) string {
+	return "/posts?page=" + strconv.Itoa(page)
+}
+
+func TestPagination(t *testing.T) {
+	got := MustRenderString(Pagination(5, 10, pageHref))
+	expected := `<nav aria-label="Pagination"><ul>` +
+		`<li><a href="/posts?page=4" rel="prev">Previous</a></li>` +
+		`<li><a href="/posts?page=1">1</a></li>` +
+		`<li><span aria-hidden="true">…</span></li>` +
+		`<li><a href="/posts?page=4">4</a></li>` +
+		`<li><a aria-current="page" href="/posts?page=5">5</a></li>` +
+		`<li><a href="/posts?page=6">6</a></li>` +
+		`<li><span aria-hidden="true">…</span></li>` +
+		`<li><a href="/posts?page=10">10</a></li>` +
+		`<li><a href="/posts?page=6" rel="next">Next</a></li>` +
+		`</ul></nav>`
+	if got != expected {
+		t.Errorf("expected: \"%s\"; got: \"%s\"", expected, got)
+	}
+}
+
+func TestPaginationEdges(t *testing.T) {
+	pages := func(n Node) string {
+		s := MustRenderString(n)
+		var labels []string
+		for _, part := range strings.Split(s, "<li>")[1:] {
+			part = part[strings.Index(part, ">")+1:]
+			labels = append(labels, part[:strings.Index(part, "<")])
+		}
+		return strings.Join(labels, " ")
+	}
+
+	tests := []struct {
+		current, total int
+		expected       string
+	}{
+		{1, 10, "Previous 1 2 … 10 Next"},
+		{10, 10, "Previous 1 … 9 10 Next"},
+		{3, 10, "Previous 1 2 3 4 … 10 Next"},
+		{4, 7, "Previous 1 2 3 4 5 6 7 Next"},
+		{0, 3, "Previous 1 2 3 Next"},
+	}
+	for _, test := range tests {
+		if got := pages(Pagination(test.current, test.total, pageHref)); got != test.expected {
+			t.Errorf("Pagination(%d, %d): expected: \"%s\"; got: \"%s\"", test.current, test.total, test.expected, got)
+		}
+	}
+
+	first := MustRenderString(Pagination(1, 3, pageHref))
+	if !strings.Contains(first, `<span aria-disabled="true">Previous</span>`) {
+		t.Errorf("expected a disabled previous item on the first page; got: \"%s\"", first)
+	}
+	last := MustRenderString(Pagination(3, 3, pageHref))
+	if !strings.Contains(last, `<span aria-disabled="true">Next</span>`) {
+		t.Errorf("expected a disabled next item on the last page; got: \"%s\"", last)
+	}
+	if got := MustRenderString(Pagination(1, 1, pageHref)); got != "" {
+		t.Errorf("expected a single page to render nothing; got: \"%s\"", got)
+	}
+}
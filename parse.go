@@ -0,0 +1,237 @@
+/**
+ * Copyright 2025 Alexis Bouchez <alexbcz@proton.me>
+ *
+ * This file is part of libhtml.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ */
+
+package html
+
+import (
+	"fmt"
+	"html"
+	"maps"
+	"slices"
+	"strings"
+
+	nethtml "golang.org/x/net/html"
+	"golang.org/x/net/html/atom"
+)
+
+// rawTextElements lists the elements whose text content is not HTML-escaped
+// <noscript> is parsed as raw text since the parser runs with scripting
+// enabled, like browsers do
+var rawTextElements = map[string]bool{
+	"script": true, "style": true, "xmp": true, "iframe": true, "noembed": true, "noframes": true,
+	"noscript": true, "plaintext": true,
+}
+
+// Parse parses an HTML string into nodes of this package, so existing markup
+// can be modified programmatically. Elements become *Tag, text becomes Text
+// (or Raw inside <script> and <style>) and comments become Comment, with
+// attributes and order preserved. A string starting with a doctype or <html>
+// is parsed as a full document, anything else as a fragment of <body>.
+// Malformed markup is repaired the way browsers do, following the HTML5
+// parsing algorithm, so rendering the result yields normalized HTML.
+// Elements and attributes whose names can't be rendered safely are dropped.
+func Parse(htmlStr string) ([]Node, error) {
+	trimmed := strings.ToLower(strings.TrimSpace(htmlStr))
+	if strings.HasPrefix(trimmed, "<!doctype") || strings.HasPrefix(trimmed, "<html") {
+		doc, err := nethtml.Parse(strings.NewReader(htmlStr))
+		if err != nil {
+			return nil, fmt.Errorf("html: parsing document: %w", err)
+		}
+		return convertDocument(doc), nil
+	}
+
+	body := &nethtml.Node{Type: nethtml.ElementNode, Data: "body", DataAtom: atom.Body}
+	nodes, err := nethtml.ParseFragment(strings.NewReader(htmlStr), body)
+	if err != nil {
+		return nil, fmt.Errorf("html: parsing fragment: %w", err)
+	}
+	return convertNodes(nodes, ""), nil
+}
+
+// convertDocument converts a parsed document, keeping its doctype if it has one
+func convertDocument(doc *nethtml.Node) []Node {
+	var children []*nethtml.Node
+	var doctype *nethtml.Node
+	for c := doc.FirstChild; c != nil; c = c.NextSibling {
+		if c.Type == nethtml.DoctypeNode {
+			doctype = c
+			continue
+		}
+		children = append(children, c)
+	}
+	nodes := convertNodes(children, "")
+	if doctype == nil {
+		return nodes
+	}
+	d := Document(nodes...)
+	sb := &strings.Builder{}
+	if err := nethtml.Render(sb, doctype); err == nil {
+		d.Doctype(sb.String())
+	}
+	return []Node{d}
+}
+
+// convertNodes converts sibling parse-tree nodes whose parent element is named parent
+func convertNodes(nodes []*nethtml.Node, parent string) []Node {
+	converted := make([]Node, 0, len(nodes))
+	for _, n := range nodes {
+		if c := convertNode(n, parent); c != nil {
+			converted = append(converted, c)
+		}
+	}
+	return converted
+}
+
+// convertNode converts a single parse-tree node, returning nil for the
+// node types that have no equivalent such as doctypes
+func convertNode(n *nethtml.Node, parent string) Node {
+	switch n.Type {
+	case nethtml.TextNode:
+		if rawTextElements[parent] {
+			return Raw(n.Data)
+		}
+		return Text(n.Data)
+	case nethtml.CommentNode:
+		return Comment(n.Data)
+	case nethtml.ElementNode:
+		// drop elements whose name would produce malformed markup once
+		// rendered, along with their content
+		if !isValidTagName(n.Data) {
+			return nil
+		}
+		var children []*nethtml.Node
+		for c := n.FirstChild; c != nil; c = c.NextSibling {
+			children = append(children, c)
+		}
+		t := NewTag(n.Data, voidElements[n.Data], convertNodes(children, n.Data))
+		for _, a := range n.Attr {
+			key := a.Key
+			if a.Namespace != "" {
+				key = a.Namespace + ":" + key
+			}
+			// drop names that would produce malformed markup once rendered
+			if !isValidAttributeName(key) {
+				continue
+			}
+			t.attributes[key] = a.Val
+		}
+		return t
+	}
+	return nil
+}
+
+// ToHTMLNode converts the tree rooted at n to the parse-tree representation of
+// golang.org/x/net/html, so it can be fed to existing passes without being
+// serialized and parsed again. Elements become ElementNode, text TextNode and
+// comments CommentNode; a document becomes a DocumentNode with its doctype.
+// Conditionals, maps and groups are resolved to the nodes they stand for, and
+// a root standing for several nodes is returned as a DocumentNode without a
+// doctype holding them. Raw HTML and any other node, such as a component, are
+// rendered and parsed in the context of their parent element.
+func ToHTMLNode(n Node) (*nethtml.Node, error) {
+	if n == nil {
+		return nil, fmt.Errorf("html: nil node")
+	}
+
+	root := &nethtml.Node{Type: nethtml.DocumentNode}
+	if d, ok := n.(*document); ok {
+		if doctype := htmlDoctype(d.doctype); doctype != nil {
+			root.AppendChild(doctype)
+		}
+		if err := appendHTMLNodes(root, d.children); err != nil {
+			return nil, err
+		}
+		return root, nil
+	}
+
+	if err := appendHTMLNodes(root, []Node{n}); err != nil {
+		return nil, err
+	}
+	if c := root.FirstChild; c != nil && c == root.LastChild {
+		root.RemoveChild(c)
+		return c, nil
+	}
+	return root, nil
+}
+
+// htmlDoctype returns the doctype node of a document doctype, or nil if it has none
+func htmlDoctype(doctype string) *nethtml.Node {
+	if doctype == defaultDoctype {
+		return &nethtml.Node{Type: nethtml.DoctypeNode, Data: "html"}
+	}
+	doc, err := nethtml.Parse(strings.NewReader(doctype))
+	if err != nil {
+		return nil
+	}
+	for c := doc.FirstChild; c != nil; c = c.NextSibling {
+		if c.Type == nethtml.DoctypeNode {
+			doc.RemoveChild(c)
+			return c
+		}
+	}
+	return nil
+}
+
+// appendHTMLNodes converts nodes and appends the result to parent
+func appendHTMLNodes(parent *nethtml.Node, nodes []Node) error {
+	for _, n := range flatten(nodes) {
+		switch v := n.(type) {
+		case *text:
+			data := v.content
+			if rawTextElements[parent.Data] {
+				// text is escaped even inside raw text elements, keep what the browser sees
+				data = html.EscapeString(data)
+			}
+			parent.AppendChild(&nethtml.Node{Type: nethtml.TextNode, Data: data})
+		case *comment:
+			parent.AppendChild(&nethtml.Node{Type: nethtml.CommentNode, Data: v.content})
+		case element:
+			t := v.base()
+			e := &nethtml.Node{
+				Type:     nethtml.ElementNode,
+				Data:     t.name,
+				DataAtom: atom.Lookup([]byte(t.name)),
+			}
+			for _, key := range slices.Sorted(maps.Keys(t.attributes)) {
+				e.Attr = append(e.Attr, nethtml.Attribute{Key: key, Val: t.attributes[key]})
+			}
+			if err := appendHTMLNodes(e, t.children); err != nil {
+				return err
+			}
+			parent.AppendChild(e)
+		default:
+			s, err := RenderString(n)
+			if err != nil {
+				return err
+			}
+			context := parent
+			if parent.Type != nethtml.ElementNode {
+				context = &nethtml.Node{Type: nethtml.ElementNode, Data: "body", DataAtom: atom.Body}
+			}
+			parsed, err := nethtml.ParseFragment(strings.NewReader(s), context)
+			if err != nil {
+				return fmt.Errorf("html: %w", err)
+			}
+			for _, p := range parsed {
+				parent.AppendChild(p)
+			}
+		}
+	}
+	return nil
+}
@@ -0,0 +1,121 @@
+/**
+ * Copyright 2025 Alexis Bouchez <alexbcz@proton.me>
+ *
+ * This file is part of libhtml.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ */
+
+package html_test
+
+import (
+	"strings"
+	"testing"
+
+	. "github.com/alexisbcz/libhtml"
+	nethtml "golang.org/x/net/html"
+)
+
+func TestParse(t *testing.T) {
+	tests := []struct {
+		input    string
+		expected string
+	}{
+		{`<div class="card" id="a"><p>Hello <b>world</b></p></div>`, `<div class="card" id="a"><p>Hello <b>world</b></p></div>`},
+		{`<p>a &amp; b &lt; c</p>`, `<p>a &amp; b &lt; c</p>`},
+		{`<img src="/a.png" alt="A"><br>`, `<img alt="A" src="/a.png"/><br/>`},
+		{`<!-- note --><span>x</span>`, `<!-- note --><span>x</span>`},
+		{`<script>if (a < b) { run() }</script>`, `<script>if (a < b) { run() }</script>`},
+		{`<input disabled>`, `<input disabled=""/>`},
+		{`<object><param name=x></object>`, `<object><param name="x"/></object>`},
+		{`<ul><li>one<li>two</ul>`, `<ul><li>one</li><li>two</li></ul>`},
+		{`<p>unclosed`, `<p>unclosed</p>`},
+		{`<svg viewBox="0 0 1 1"><use xlink:href="#i"></use></svg>`, `<svg viewBox="0 0 1 1"><use xlink:href="#i"></use></svg>`},
+		{`<!DOCTYPE html><html lang="en"><head><title>T</title></head><body>x</body></html>`, `<!DOCTYPE html><html lang="en"><head><title>T</title></head><body>x</body></html>`},
+		{`<noscript><p>hi</p></noscript>`, `<noscript><p>hi</p></noscript>`},
+		{`<plaintext><b>x</b>`, `<plaintext><b>x</b></plaintext>`},
+		{`<div a"b='x' id="ok">y</div>`, `<div id="ok">y</div>`},
+		{`<p><a"onclick=alert(1)>x</a"onclick=alert(1)>y</p>`, `<p>y</p>`},
+		{``, ``},
+	}
+
+	for _, test := range tests {
+		nodes, err := Parse(test.input)
+		if err != nil {
+			t.Fatal(err)
+		}
+		got, err := RenderString(Group(nodes...))
+		if err != nil {
+			t.Fatal(err)
+		}
+		if got != test.expected {
+			t.Errorf("Parse(%q): expected: \"%s\"; got: \"%s\"", test.input, test.expected, got)
+		}
+	}
+}
+
+func TestParseModify(t *testing.T) {
+	nodes, err := Parse(`<div class="legacy">content</div>`)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	div, ok := nodes[0].(*Tag)
+	if !ok {
+		t.Fatalf("expected a *Tag; got: %T", nodes[0])
+	}
+	div.AddClass("modern").Attribute("data-migrated", "true")
+
+	if got := MustRenderString(div); got != `<div class="legacy modern" data-migrated="true">content</div>` {
+		t.Errorf("unexpected render after modification: \"%s\"", got)
+	}
+}
+
+func TestToHTMLNode(t *testing.T) {
+	tests := []struct {
+		node     Node
+		expected string
+	}{
+		{
+			Document(HTML(Head(Title(Text("T"))), Body(P(Text("a & b")).Class("x"), Br())).Lang("en")),
+			`<!DOCTYPE html><html lang="en"><head><title>T</title></head><body><p class="x">a &amp; b</p><br/></body></html>`,
+		},
+		{Div(Comment("c"), Raw("<em>raw</em> tail"), If(true, Span())), `<div><!--c--><em>raw</em> tail<span></span></div>`},
+		{Ul(Map([]string{"a", "b"}, func(s string) Node { return Li(Text(s)) })), `<ul><li>a</li><li>b</li></ul>`},
+		{Group(P(), P()), `<p></p><p></p>`},
+		{C(Card{Title: "Hi", Body: "there"}), `<div class="card"><h2>Hi</h2><p>there</p></div>`},
+	}
+
+	for _, test := range tests {
+		n, err := ToHTMLNode(test.node)
+		if err != nil {
+			t.Fatal(err)
+		}
+		var sb strings.Builder
+		if err := nethtml.Render(&sb, n); err != nil {
+			t.Fatal(err)
+		}
+		if sb.String() != test.expected {
+			t.Errorf("expected: \"%s\"; got: \"%s\"", test.expected, sb.String())
+		}
+	}
+
+	n, err := ToHTMLNode(Div(Span()))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if n.Type != nethtml.ElementNode || n.Data != "div" || n.Parent != nil || n.FirstChild.Data != "span" {
+		t.Errorf("expected a detached div element with a span child; got: %+v", n)
+	}
+}
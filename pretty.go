@@ -0,0 +1,171 @@
+/**
+ * Copyright 2025 Alexis Bouchez <alexbcz@proton.me>
+ *
+ * This file is part of libhtml.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ */
+
+package html
+
+import (
+	"context"
+	"io"
+	"strings"
+)
+
+// blockElements lists the elements the indenting renderer puts on their own line
+var blockElements = map[string]bool{
+	"html": true, "head": true, "body": true, "title": true, "meta": true, "link": true,
+	"base": true, "script": true, "style": true, "noscript": true, "template": true,
+	"address": true, "article": true, "aside": true, "blockquote": true, "details": true,
+	"dialog": true, "summary": true, "div": true, "dl": true, "dt": true, "dd": true,
+	"fieldset": true, "legend": true, "figure": true, "figcaption": true, "footer": true,
+	"form": true, "h1": true, "h2": true, "h3": true, "h4": true, "h5": true, "h6": true,
+	"header": true, "hgroup": true, "hr": true, "main": true, "nav": true, "section": true,
+	"p": true, "pre": true, "ul": true, "ol": true, "li": true, "menu": true, "table": true,
+	"caption": true, "colgroup": true, "thead": true, "tbody": true, "tfoot": true,
+	"tr": true, "th": true, "td": true, "select": true, "optgroup": true, "option": true,
+}
+
+// verbatimElements lists the whitespace-sensitive elements whose content is never indented
+var verbatimElements = map[string]bool{
+	"pre":      true,
+	"textarea": true,
+	"script":   true,
+	"style":    true,
+}
+
+// RenderIndent renders n with newlines and indentation for readability
+// Block elements are put on their own line and their children are indented by
+// one level of indent, while inline elements and text stay compact on a line.
+// The content of <pre>, <textarea>, <script> and <style> is written verbatim.
+// This is meant for debugging; Render remains the compact default.
+func RenderIndent(n Node, w io.Writer, indent string) error {
+	return renderIndent(context.Background(), n, w, indent)
+}
+
+// renderIndent renders n like RenderIndent with ctx
+func renderIndent(ctx context.Context, n Node, w io.Writer, indent string) error {
+	p := &indentPrinter{w: w, indent: indent}
+	return p.nodes(ctx, []Node{n}, 0)
+}
+
+// indentPrinter writes a node tree with one nesting level per line indentation
+type indentPrinter struct {
+	w      io.Writer
+	indent string
+
+	// started reports whether anything was written yet, so the first line
+	// isn't preceded by a newline
+	started bool
+}
+
+// newline starts a new line indented for the given depth
+func (p *indentPrinter) newline(depth int) error {
+	if p.started {
+		if _, err := io.WriteString(p.w, "\n"); err != nil {
+			return err
+		}
+	}
+	p.started = true
+	_, err := io.WriteString(p.w, strings.Repeat(p.indent, depth))
+	return err
+}
+
+// nodes writes sibling nodes, giving every block element its own line and
+// keeping consecutive inline nodes together on a single line
+func (p *indentPrinter) nodes(ctx context.Context, nodes []Node, depth int) error {
+	return p.items(flattenContext(ctx, nodes, nil), depth)
+}
+
+// items writes flattened sibling nodes like nodes does
+func (p *indentPrinter) items(items []contextNode, depth int) error {
+	inline := false
+	for _, item := range items {
+		n, ctx := item.node, item.ctx
+		if d, ok := n.(*document); ok {
+			if d.doctype != "" {
+				if err := p.newline(depth); err != nil {
+					return err
+				}
+				if _, err := io.WriteString(p.w, d.doctype); err != nil {
+					return err
+				}
+			}
+			if err := p.nodes(ctx, d.children, depth); err != nil {
+				return err
+			}
+			inline = false
+			continue
+		}
+
+		if e, ok := n.(element); ok && blockElements[e.base().name] {
+			if err := p.newline(depth); err != nil {
+				return err
+			}
+			if err := p.block(ctx, e.base(), item.attributes(), depth); err != nil {
+				return err
+			}
+			inline = false
+			continue
+		}
+
+		if !inline {
+			if err := p.newline(depth); err != nil {
+				return err
+			}
+			inline = true
+		}
+		if err := item.render(p.w); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// block writes a block element with the extra attributes added at render time,
+// spreading its children over indented lines when at least one of them is a
+// block element itself
+func (p *indentPrinter) block(ctx context.Context, t *Tag, extra []attr, depth int) error {
+	if t.isVoid || verbatimElements[t.name] {
+		return t.renderContext(ctx, p.w, extra)
+	}
+
+	children := flattenContext(ctx, t.children, nil)
+	if !hasBlock(children) {
+		return t.renderContext(ctx, p.w, extra)
+	}
+
+	if err := t.writeOpen(p.w, append(t.contextAttributes(ctx), extra...), modeFrom(ctx)); err != nil {
+		return err
+	}
+	if err := p.items(children, depth+1); err != nil {
+		return err
+	}
+	if err := p.newline(depth); err != nil {
+		return err
+	}
+	return t.writeClose(p.w)
+}
+
+// hasBlock reports whether any of the nodes is a block element
+func hasBlock(items []contextNode) bool {
+	for _, item := range items {
+		if e, ok := item.node.(element); ok && blockElements[e.base().name] {
+			return true
+		}
+	}
+	return false
+}
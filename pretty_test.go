@@ -0,0 +1,70 @@
+/**
+ * Copyright 2025 Alexis Bouchez <alexbcz@proton.me>
+ *
+ * This file is part of libhtml.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ */
+
+package html_test
+
+import (
+	"strings"
+	"testing"
+
+	. "github.com/alexisbcz/libhtml"
+)
+
+func TestRenderIndent(t *testing.T) {
+	doc := Document(
+		HTML(
+			Head(Title(Text("Demo"))),
+			Body(
+				Div(
+					P(Text("Hello "), B(Text("world"))),
+					Ul(Map([]string{"a", "b"}, func(s string) Node {
+						return Li(Text(s))
+					})),
+				).Class("card"),
+				Pre(Text("  keep\n  as is")),
+			),
+		).Lang("en"),
+	)
+
+	const expected = `<!DOCTYPE html>
+<html lang="en">
+  <head>
+    <title>Demo</title>
+  </head>
+  <body>
+    <div class="card">
+      <p>Hello <b>world</b></p>
+      <ul>
+        <li>a</li>
+        <li>b</li>
+      </ul>
+    </div>
+    <pre>  keep
+  as is</pre>
+  </body>
+</html>`
+
+	sb := &strings.Builder{}
+	if err := RenderIndent(doc, sb, "  "); err != nil {
+		t.Fatal(err)
+	}
+	if got := sb.String(); got != expected {
+		t.Errorf("expected:\n%s\ngot:\n%s", expected, got)
+	}
+}
@@ -0,0 +1,103 @@
+/**
+ * Copyright 2025 Alexis Bouchez <alexbcz@proton.me>
+ *
+ * This file is part of libhtml.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ */
+
+package html
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"strings"
+)
+
+// errorNode fails to render with err, reporting errors of chained setters
+type errorNode struct {
+	err error
+}
+
+// Render implements Node.Render for errorNode
+func (e *errorNode) Render(w io.Writer) error {
+	return e.err
+}
+
+// JSON sets the body of the script to v encoded as JSON, replacing any
+// children, for passing data to the client in a <script type="application/json">
+// '<', '>' and '&' are escaped as \u003c, \u003e and \u0026, so the data can't
+// close the script or open a comment. If v can't be encoded, rendering the
+// script fails with the encoding error.
+// Returns the element itself to enable method chaining
+func (e *script) JSON(v any) *script {
+	data, err := json.Marshal(v)
+	if err != nil {
+		e.children = []Node{&errorNode{err: fmt.Errorf("html: script json: %w", err)}}
+		return e
+	}
+	e.children = []Node{Raw(string(data))}
+	return e
+}
+
+// JSONLD creates the <script type="application/ld+json"> holding v encoded as
+// JSON, the way to add schema.org structured data to a page
+// The JSON is escaped like script.JSON does, so strings containing "</script>"
+// can't end the element early
+func JSONLD(v any) *script {
+	return Script().Type("application/ld+json").JSON(v)
+}
+
+// JS sets the body of the script to code, replacing any children
+// The code is not HTML-escaped, but any "</script" is written as "<\/script"
+// and any "<!--" as "<\!--", which are equivalent inside JavaScript strings,
+// so the code can't end the script element early
+// Returns the element itself to enable method chaining
+func (e *script) JS(code string) *script {
+	code = escapeEndTag(code, "script")
+	code = strings.ReplaceAll(code, "<!--", `<\!--`)
+	e.children = []Node{Raw(code)}
+	return e
+}
+
+// escapeEndTag writes every "</name", matched ASCII case-insensitively like
+// browsers do, as "<\/name" so it can't close a raw text element
+func escapeEndTag(s, name string) string {
+	needle := "</" + name
+	var sb strings.Builder
+	start := 0
+	for i := 0; i+len(needle) <= len(s); i++ {
+		if s[i] == '<' && strings.EqualFold(s[i:i+len(needle)], needle) {
+			sb.WriteString(s[start : i+1])
+			sb.WriteString(`\`)
+			start = i + 1
+		}
+	}
+	if start == 0 {
+		return s
+	}
+	sb.WriteString(s[start:])
+	return sb.String()
+}
+
+// CSS sets the body of the style element to css, replacing any children
+// The CSS is not HTML-escaped, so selectors like "a > b" are kept, but any
+// "</style" is written as "<\/style", an equivalent CSS escape, so the CSS
+// can't end the style element early
+// Returns the element itself to enable method chaining
+func (e *style) CSS(css string) *style {
+	e.children = []Node{Raw(escapeEndTag(css, "style"))}
+	return e
+}
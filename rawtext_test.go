@@ -0,0 +1,121 @@
+/**
+ * Copyright 2025 Alexis Bouchez <alexbcz@proton.me>
+ *
+ * This file is part of libhtml.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ */
+
+package html_test
+
+import (
+	"testing"
+
+	. "github.com/alexisbcz/libhtml"
+)
+
+func TestScriptJSON(t *testing.T) {
+	data := map[string]any{
+		"user":  "</script><script>alert(1)</script>",
+		"note":  "<!-- & -->",
+		"count": 3,
+	}
+	got, err := RenderString(Script(Text("replaced")).Type("application/json").JSON(data).ID("boot"))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	expected := `<script id="boot" type="application/json">` +
+		`{"count":3,"note":"\u003c!-- \u0026 --\u003e","user":"\u003c/script\u003e\u003cscript\u003ealert(1)\u003c/script\u003e"}` +
+		`</script>`
+	if got != expected {
+		t.Errorf("expected: \"%s\"; got: \"%s\"", expected, got)
+	}
+
+	if _, err := RenderString(Script().JSON(func() {})); err == nil {
+		t.Error("expected an error for a value that can't be encoded")
+	}
+}
+
+func TestJSONLD(t *testing.T) {
+	type person struct {
+		Type string `json:"@type"`
+		Name string `json:"name"`
+	}
+	type article struct {
+		Context  string `json:"@context"`
+		Type     string `json:"@type"`
+		Headline string `json:"headline"`
+		Author   person `json:"author"`
+	}
+
+	node := JSONLD(article{
+		Context:  "https://schema.org",
+		Type:     "Article",
+		Headline: "Tips & tricks for </script><script>alert(1)</script>",
+		Author:   person{Type: "Person", Name: "Ada <Lovelace>"},
+	})
+	expected := `<script type="application/ld+json">{"@context":"https://schema.org","@type":"Article",` +
+		`"headline":"Tips \u0026 tricks for \u003c/script\u003e\u003cscript\u003ealert(1)\u003c/script\u003e",` +
+		`"author":{"@type":"Person","name":"Ada \u003cLovelace\u003e"}}</script>`
+	if got := MustRenderString(node); got != expected {
+		t.Errorf("expected: \"%s\"; got: \"%s\"", expected, got)
+	}
+
+	if _, err := RenderString(JSONLD(func() {})); err == nil {
+		t.Error("expected an error for a value that can't be encoded")
+	}
+}
+
+func TestScriptJS(t *testing.T) {
+	tests := []struct {
+		code     string
+		expected string
+	}{
+		{`if (a < b && c > d) run()`, `<script>if (a < b && c > d) run()</script>`},
+		{`document.write("</script><script>")`, `<script>document.write("<\/script><script>")</script>`},
+		{`x = "</SCRIPT >"; y = "<!--"`, `<script>x = "<\/SCRIPT >"; y = "<\!--"</script>`},
+	}
+
+	for _, test := range tests {
+		got, err := RenderString(Script().JS(test.code))
+		if err != nil {
+			t.Fatal(err)
+		}
+		if got != test.expected {
+			t.Errorf("expected: \"%s\"; got: \"%s\"", test.expected, got)
+		}
+	}
+}
+
+func TestStyleCSS(t *testing.T) {
+	tests := []struct {
+		css      string
+		expected string
+	}{
+		{`nav > a + a { content: "&"; }`, `<style>nav > a + a { content: "&"; }</style>`},
+		{`a { content: "</style><script>alert(1)</script>"; }`, `<style>a { content: "<\/style><script>alert(1)</script>"; }</style>`},
+		{`b::after { content: "</STYLE" }`, `<style>b::after { content: "<\/STYLE" }</style>`},
+	}
+
+	for _, test := range tests {
+		got, err := RenderString(Style(Text("replaced")).CSS(test.css))
+		if err != nil {
+			t.Fatal(err)
+		}
+		if got != test.expected {
+			t.Errorf("expected: \"%s\"; got: \"%s\"", test.expected, got)
+		}
+	}
+}
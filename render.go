@@ -0,0 +1,364 @@
+/**
+ * Copyright 2025 Alexis Bouchez <alexbcz@proton.me>
+ *
+ * This file is part of libhtml.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ */
+
+package html
+
+import (
+	"bytes"
+	"compress/gzip"
+	"fmt"
+	htmltemplate "html/template"
+	"io"
+	"net/http"
+	"sync"
+)
+
+// maxPooledBuffer is the largest buffer capacity returned to bufferPool, so an
+// occasional huge render doesn't stay pinned in memory
+const maxPooledBuffer = 64 << 10
+
+// bufferPool holds scratch buffers reused across renders to reduce allocations
+var bufferPool = sync.Pool{
+	New: func() any {
+		return new(bytes.Buffer)
+	},
+}
+
+// getBuffer returns an empty scratch buffer from the pool
+func getBuffer() *bytes.Buffer {
+	buf := bufferPool.Get().(*bytes.Buffer)
+	buf.Reset()
+	return buf
+}
+
+// putBuffer returns a scratch buffer to the pool
+func putBuffer(buf *bytes.Buffer) {
+	if buf.Cap() > maxPooledBuffer {
+		return
+	}
+	bufferPool.Put(buf)
+}
+
+// RenderBytes renders the given node and returns the resulting HTML as bytes
+// A nil node renders to an empty slice
+func RenderBytes(n Node) ([]byte, error) {
+	if n == nil {
+		return []byte{}, nil
+	}
+	buf := getBuffer()
+	defer putBuffer(buf)
+	buf.Grow(EstimatedSize(n))
+	if err := RenderWith(n, buf, RenderOptions{}); err != nil {
+		return nil, err
+	}
+	b := make([]byte, buf.Len())
+	copy(b, buf.Bytes())
+	return b, nil
+}
+
+// AppendRender appends the rendered HTML of n to dst and returns the extended
+// slice, like the Append functions of strconv, so a buffer can be reused
+// across renders without allocating. The output is the same as Render's. On
+// error dst is returned unchanged along with the error.
+func AppendRender(dst []byte, n Node) ([]byte, error) {
+	if n == nil {
+		return dst, nil
+	}
+	sw := &sliceWriter{b: dst}
+	if err := n.Render(sw); err != nil {
+		return dst, err
+	}
+	return sw.b, nil
+}
+
+// sliceWriter appends everything written to it to a byte slice
+type sliceWriter struct {
+	b []byte
+}
+
+// Write implements io.Writer for sliceWriter
+func (s *sliceWriter) Write(p []byte) (int, error) {
+	s.b = append(s.b, p...)
+	return len(p), nil
+}
+
+// WriteString implements io.StringWriter for sliceWriter, saving the
+// conversion of strings to byte slices
+func (s *sliceWriter) WriteString(str string) (int, error) {
+	s.b = append(s.b, str...)
+	return len(str), nil
+}
+
+// EstimatedSize returns an approximate number of bytes n renders to, meant
+// for presizing output buffers. Static content is measured with some slack
+// for escaping so the result is usually an over-estimate; nodes built at
+// render time, such as Map, IfFunc or custom components, only count as a
+// small fixed guess since measuring them would build them twice.
+func EstimatedSize(n Node) int {
+	switch n := n.(type) {
+	case nil:
+		return 0
+	case *text:
+		return len(n.content) + len(n.content)/4
+	case *raw:
+		return len(n.content)
+	case *comment:
+		return len(n.content) + 7
+	case *document:
+		return len(n.doctype) + estimatedSizeAll(n.children)
+	case *group:
+		return estimatedSizeAll(n.children)
+	case *if_:
+		if n.condition {
+			return EstimatedSize(n.then)
+		}
+		return 0
+	case *ifElse:
+		if n.condition {
+			return EstimatedSize(n.then)
+		}
+		return EstimatedSize(n.else_)
+	case *keyed:
+		return len(KeyAttribute) + len(n.key) + 4 + EstimatedSize(n.node)
+	case *withNonce:
+		return EstimatedSize(n.node)
+	case *withMode:
+		return EstimatedSize(n.node)
+	case element:
+		t := n.base()
+		size := len(t.name) + 2
+		for k, v := range t.attributes {
+			size += len(k) + len(v) + len(v)/4 + 4
+		}
+		if t.isVoid {
+			return size + 1
+		}
+		return size + len(t.name) + 3 + estimatedSizeAll(t.children)
+	}
+	return unknownNodeSize
+}
+
+// unknownNodeSize is the guess EstimatedSize uses for nodes it can't measure
+const unknownNodeSize = 64
+
+// estimatedSizeAll sums the estimated sizes of nodes
+func estimatedSizeAll(nodes []Node) int {
+	size := 0
+	for _, c := range nodes {
+		size += EstimatedSize(c)
+	}
+	return size
+}
+
+// RenderString renders the given node and returns the resulting HTML as a string
+// A nil node renders to an empty string
+func RenderString(n Node) (string, error) {
+	if n == nil {
+		return "", nil
+	}
+	buf := getBuffer()
+	defer putBuffer(buf)
+	buf.Grow(EstimatedSize(n))
+	if err := RenderWith(n, buf, RenderOptions{}); err != nil {
+		return "", err
+	}
+	return buf.String(), nil
+}
+
+// HTMLValue renders the given node and returns the result as the
+// template.HTML type of html/template, so a fragment built with this package
+// can be passed to an html/template template without being escaped twice
+// The returned value is trusted by design: html/template writes it verbatim,
+// bypassing its own escaping, so it is only as safe as the node, e.g. Raw
+// content is passed through as is
+// A nil node renders to an empty value
+func HTMLValue(n Node) (htmltemplate.HTML, error) {
+	s, err := RenderString(n)
+	if err != nil {
+		return "", err
+	}
+	return htmltemplate.HTML(s), nil
+}
+
+// RenderError reports where in the tree rendering failed
+// Errors from elements and their children are wrapped in a RenderError whose
+// Path lists the elements from the outermost down to the failing one, and
+// the underlying error stays available to errors.Is and errors.As.
+type RenderError struct {
+	// Path is the chain of element names, such as "html > body > div"
+	Path string
+
+	// Err is the error returned by the writer or a custom node
+	Err error
+}
+
+// Error implements the error interface for RenderError
+func (e *RenderError) Error() string {
+	return "rendering " + e.Path + ": " + e.Err.Error()
+}
+
+// Unwrap returns the underlying error
+func (e *RenderError) Unwrap() error {
+	return e.Err
+}
+
+// wrapRenderError prepends the element name to the path of err, wrapping it
+// in a RenderError first if it isn't one yet
+func wrapRenderError(name string, err error) error {
+	if re, ok := err.(*RenderError); ok {
+		return &RenderError{Path: name + " > " + re.Path, Err: re.Err}
+	}
+	return &RenderError{Path: name, Err: err}
+}
+
+// renderDebugString renders n for the String methods of nodes, which can't
+// return an error, so a failed render is reported in an HTML comment instead
+func renderDebugString(n Node) string {
+	s, err := RenderString(n)
+	if err != nil {
+		return "<!-- render error: " + escapeComment(err.Error()) + " -->"
+	}
+	return s
+}
+
+// MustRenderString is like RenderString but panics if rendering fails
+// Intended for templates and tests where a render error is a programming mistake
+func MustRenderString(n Node) string {
+	s, err := RenderString(n)
+	if err != nil {
+		panic(err)
+	}
+	return s
+}
+
+// RenderReader renders n in a goroutine into an io.Pipe and returns its read
+// end, so the consumer pulls the HTML at its own pace and rendering blocks
+// until it does. A render error is returned by Read once the output written
+// before it has been read. Closing the reader early makes the pending and
+// later writes fail, which ends the render and its goroutine.
+func RenderReader(n Node) io.ReadCloser {
+	pr, pw := io.Pipe()
+	go func() {
+		pw.CloseWithError(Render(n, pw))
+	}()
+	return pr
+}
+
+// RenderGzip renders n gzip-compressed into w at the given compression level,
+// one of the levels accepted by compress/gzip such as gzip.BestSpeed or
+// gzip.DefaultCompression. The gzip stream is closed, but w itself isn't.
+func RenderGzip(n Node, w io.Writer, level int) error {
+	zw, err := gzip.NewWriterLevel(w, level)
+	if err != nil {
+		return fmt.Errorf("html: %w", err)
+	}
+	if n != nil {
+		if err := n.Render(zw); err != nil {
+			zw.Close()
+			return err
+		}
+	}
+	return zw.Close()
+}
+
+// RenderFlush renders n into w, flushing w after each direct child of a
+// document or group so the browser can start parsing before the whole page
+// is rendered. The <html> element of a document is looked through as well, so
+// its <head> is flushed before the <body> is rendered. w is flushed when it
+// implements http.Flusher or has a Flush() error method such as bufio.Writer;
+// otherwise n is rendered normally.
+func RenderFlush(n Node, w io.Writer) error {
+	flush := flusher(w)
+	if flush == nil || n == nil {
+		return Render(n, w)
+	}
+
+	switch v := n.(type) {
+	case *document:
+		if _, err := io.WriteString(w, v.doctype); err != nil {
+			return err
+		}
+		for _, child := range v.children {
+			if e, ok := child.(element); ok && e.base().name == "html" {
+				if err := renderFlushTag(e.base(), w, flush); err != nil {
+					return err
+				}
+				continue
+			}
+			if err := renderFlushChildren([]Node{child}, w, flush); err != nil {
+				return err
+			}
+		}
+		return nil
+	case *group:
+		return renderFlushChildren(v.children, w, flush)
+	}
+
+	if err := n.Render(w); err != nil {
+		return err
+	}
+	return flush()
+}
+
+// renderFlushTag renders t, flushing after each of its direct children
+func renderFlushTag(t *Tag, w io.Writer, flush func() error) error {
+	if err := t.writeOpen(w, nil, DefaultMode); err != nil {
+		return wrapRenderError(t.name, err)
+	}
+	if t.isVoid {
+		return flush()
+	}
+	if err := renderFlushChildren(t.children, w, flush); err != nil {
+		return wrapRenderError(t.name, err)
+	}
+	if err := t.writeClose(w); err != nil {
+		return wrapRenderError(t.name, err)
+	}
+	return flush()
+}
+
+// renderFlushChildren renders every non-nil node, flushing after each of them
+func renderFlushChildren(nodes []Node, w io.Writer, flush func() error) error {
+	for _, n := range nodes {
+		if n == nil {
+			continue
+		}
+		if err := n.Render(w); err != nil {
+			return err
+		}
+		if err := flush(); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// flusher returns the flush method of w, or nil if it has none
+func flusher(w io.Writer) func() error {
+	switch f := w.(type) {
+	case interface{ Flush() error }:
+		return f.Flush
+	case http.Flusher:
+		return func() error {
+			f.Flush()
+			return nil
+		}
+	}
+	return nil
+}
@@ -0,0 +1,466 @@
+/**
+ * Copyright 2025 Alexis Bouchez <alexbcz@proton.me>
+ *
+ * This file is part of libhtml.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ */
+
+package html_test
+
+import (
+	"bytes"
+	"compress/gzip"
+	"context"
+	"errors"
+	"fmt"
+	"html/template"
+	"io"
+	"strings"
+	"sync"
+	"testing"
+
+	. "github.com/alexisbcz/libhtml"
+)
+
+// failingNode is a custom Node whose rendering always fails
+type failingNode struct{}
+
+var errFailingNode = errors.New("failing node")
+
+func (failingNode) Render(w io.Writer) error {
+	return errFailingNode
+}
+
+func TestRenderString(t *testing.T) {
+	got, err := RenderString(P(Text("Hello")).Class("intro"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if expected := `<p class="intro">Hello</p>`; got != expected {
+		t.Errorf("expected: \"%s\"; got: \"%s\"", expected, got)
+	}
+
+	if got, err := RenderString(nil); err != nil || got != "" {
+		t.Errorf("expected empty string for nil node; got: \"%s\", %v", got, err)
+	}
+
+	if _, err := RenderString(Div(failingNode{})); !errors.Is(err, errFailingNode) {
+		t.Errorf("expected render error; got: %v", err)
+	}
+}
+
+func TestRenderBytes(t *testing.T) {
+	got, err := RenderBytes(Span(Text("a & b")))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if expected := `<span>a &amp; b</span>`; string(got) != expected {
+		t.Errorf("expected: \"%s\"; got: \"%s\"", expected, got)
+	}
+
+	got, err = RenderBytes(nil)
+	if err != nil || got == nil || len(got) != 0 {
+		t.Errorf("expected empty slice for nil node; got: %v, %v", got, err)
+	}
+}
+
+func TestMustRenderString(t *testing.T) {
+	if got := MustRenderString(Br()); got != "<br/>" {
+		t.Errorf("expected: \"<br/>\"; got: \"%s\"", got)
+	}
+
+	defer func() {
+		if recover() == nil {
+			t.Error("expected MustRenderString to panic on render error")
+		}
+	}()
+	MustRenderString(failingNode{})
+}
+
+func benchmarkPage() Node {
+	type row struct {
+		ID    int
+		Name  string
+		Email string
+	}
+	rows := make([]row, 50)
+	for i := range rows {
+		rows[i] = row{ID: i, Name: "User", Email: "user@example.com"}
+	}
+
+	return Document(HTML(
+		Head(
+			Meta().Charset("utf-8"),
+			Title(Text("Dashboard")),
+			Link().Rel("stylesheet").Href("/app.css"),
+		),
+		Body(
+			Nav(Ul(
+				Li(A(Text("Home")).Href("/").Class("nav-link active")),
+				Li(A(Text("Users")).Href("/users").Class("nav-link")),
+			)).Class("navbar"),
+			Main(
+				H1(Text("Users")).Class("title"),
+				Table(Tbody(Map(rows, func(r row) Node {
+					return Tr(
+						Td(Textf("%d", r.ID)).Class("cell"),
+						Td(Text(r.Name)).Class("cell"),
+						Td(A(Text(r.Email)).Href("mailto:"+r.Email)).Class("cell"),
+					).Attribute("data-id", "row")
+				}))).Class("table table-striped"),
+			).ID("content"),
+		),
+	).Lang("en"))
+}
+
+func BenchmarkRenderPage(b *testing.B) {
+	page := benchmarkPage()
+	b.ReportAllocs()
+	for b.Loop() {
+		if _, err := RenderString(page); err != nil {
+			b.Fatal(err)
+		}
+	}
+}
+
+func TestRenderGzip(t *testing.T) {
+	page := Document(HTML(Body(P(Text("compressed")))))
+
+	buf := &bytes.Buffer{}
+	if err := RenderGzip(page, buf, gzip.BestCompression); err != nil {
+		t.Fatal(err)
+	}
+
+	zr, err := gzip.NewReader(buf)
+	if err != nil {
+		t.Fatal(err)
+	}
+	got, err := io.ReadAll(zr)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if expected := MustRenderString(page); string(got) != expected {
+		t.Errorf("expected: \"%s\"; got: \"%s\"", expected, got)
+	}
+
+	if err := RenderGzip(page, io.Discard, 42); err == nil {
+		t.Error("expected an error for an invalid compression level")
+	}
+	if err := RenderGzip(failingNode{}, io.Discard, gzip.DefaultCompression); !errors.Is(err, errFailingNode) {
+		t.Errorf("expected render error; got: %v", err)
+	}
+}
+
+// flushRecorder records the content written up to each flush
+type flushRecorder struct {
+	strings.Builder
+	flushes []string
+}
+
+func (f *flushRecorder) Flush() error {
+	f.flushes = append(f.flushes, f.String())
+	return nil
+}
+
+func TestRenderFlush(t *testing.T) {
+	page := Document(HTML(
+		Head(Title(Text("T"))),
+		Body(P(Text("slow"))),
+	).Lang("en"))
+
+	rec := &flushRecorder{}
+	if err := RenderFlush(page, rec); err != nil {
+		t.Fatal(err)
+	}
+	if expected := MustRenderString(page); rec.String() != expected {
+		t.Errorf("expected: \"%s\"; got: \"%s\"", expected, rec.String())
+	}
+
+	expected := []string{
+		`<!DOCTYPE html><html lang="en"><head><title>T</title></head>`,
+		`<!DOCTYPE html><html lang="en"><head><title>T</title></head><body><p>slow</p></body>`,
+		`<!DOCTYPE html><html lang="en"><head><title>T</title></head><body><p>slow</p></body></html>`,
+	}
+	if len(rec.flushes) != len(expected) {
+		t.Fatalf("expected %d flushes; got: %d (%q)", len(expected), len(rec.flushes), rec.flushes)
+	}
+	for i := range expected {
+		if rec.flushes[i] != expected[i] {
+			t.Errorf("flush %d: expected: \"%s\"; got: \"%s\"", i, expected[i], rec.flushes[i])
+		}
+	}
+
+	rec = &flushRecorder{}
+	if err := RenderFlush(Group(P(Text("a")), nil, P(Text("b"))), rec); err != nil {
+		t.Fatal(err)
+	}
+	if len(rec.flushes) != 2 || rec.flushes[0] != "<p>a</p>" {
+		t.Errorf("expected a flush after each group child; got: %q", rec.flushes)
+	}
+
+	sb := &strings.Builder{}
+	if err := RenderFlush(page, sb); err != nil {
+		t.Fatal(err)
+	}
+	if sb.String() != MustRenderString(page) {
+		t.Errorf("expected normal rendering without a flusher; got: \"%s\"", sb.String())
+	}
+}
+
+func TestAppendRender(t *testing.T) {
+	page := benchmarkPage()
+	expected := MustRenderString(page)
+
+	buf := []byte("prefix:")
+	buf, err := AppendRender(buf, page)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if string(buf) != "prefix:"+expected {
+		t.Errorf("expected the render appended to the prefix; got: \"%s\"", buf)
+	}
+
+	buf, err = AppendRender(buf[:0], Div(Text("reused")))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if string(buf) != "<div>reused</div>" {
+		t.Errorf("expected: \"<div>reused</div>\"; got: \"%s\"", buf)
+	}
+
+	if got, err := AppendRender(buf[:0], nil); err != nil || len(got) != 0 {
+		t.Errorf("expected a nil node to append nothing; got: %q, %v", got, err)
+	}
+	if got, err := AppendRender([]byte("kept"), Div(failingNode{})); !errors.Is(err, errFailingNode) || string(got) != "kept" {
+		t.Errorf("expected dst unchanged and the render error; got: %q, %v", got, err)
+	}
+}
+
+// staticPage returns a prebuilt page without nodes built at render time
+func staticPage() Node {
+	rows := make([]Node, 50)
+	for i := range rows {
+		rows[i] = Tr(
+			Td(Textf("%d", i)).Class("cell"),
+			Td(Text("User")).Class("cell"),
+			Td(A(Text("user@example.com")).Href("mailto:user@example.com")).Class("cell"),
+		)
+	}
+	return Document(HTML(Body(Table(Tbody(rows...)).Class("table"))))
+}
+
+func BenchmarkRenderBytes(b *testing.B) {
+	page := staticPage()
+	b.ReportAllocs()
+	for b.Loop() {
+		if _, err := RenderBytes(page); err != nil {
+			b.Fatal(err)
+		}
+	}
+}
+
+func BenchmarkAppendRender(b *testing.B) {
+	page := staticPage()
+	var buf []byte
+	b.ReportAllocs()
+	for b.Loop() {
+		var err error
+		if buf, err = AppendRender(buf[:0], page); err != nil {
+			b.Fatal(err)
+		}
+	}
+}
+
+func TestEstimatedSize(t *testing.T) {
+	if got := EstimatedSize(nil); got != 0 {
+		t.Errorf("expected: 0; got: %d", got)
+	}
+	for name, n := range map[string]Node{
+		"static":  staticPage(),
+		"text":    P(Text("Fish & chips, served daily")).Title("Menu"),
+		"void":    Group(Br(), Img().Src("/a.png").Alt("a"), Hr()),
+		"comment": Group(Comment("note"), Raw("<b>raw</b>")),
+		"if":      Div(If(true, Span(Text("shown"))), IfElse(false, B(), I(Text("else")))),
+		"keyed":   Keyed("row-1", Li(Text("item"))),
+	} {
+		out, err := RenderBytes(n)
+		if err != nil {
+			t.Fatalf("%s: %v", name, err)
+		}
+		if got := EstimatedSize(n); got < len(out) {
+			t.Errorf("%s: expected at least %d; got: %d", name, len(out), got)
+		}
+	}
+}
+
+// TestConcurrentRender renders one shared tree from many goroutines, run it
+// with -race to catch shared mutable state in the render path
+func TestConcurrentRender(t *testing.T) {
+	page := Layout(func(slots *Slots) Node {
+		return Document(HTML(
+			Head(Title(Text("Shared")), Memo(Style(Text("body{margin:0}")))),
+			Body(slots.Slot("main")),
+		))
+	})
+	page.Fill("main",
+		WithNonce(Script(Text("init()")), "abc"),
+		KeyedMap([]string{"a", "b", "c"}, func(s string) string { return s }, func(s string) Node {
+			return Li(Text(s)).Class("item")
+		}),
+		IfFunc(true, func() Node { return P(Text("lazy")) }),
+		Repeat(3, func(i int) Node { return Span(Textf("%d", i)) }),
+		Memo(staticPage()),
+	)
+
+	want, err := RenderString(page)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	var wg sync.WaitGroup
+	for range 16 {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for range 20 {
+				var buf bytes.Buffer
+				if err := RenderContext(context.Background(), page, &buf); err != nil {
+					t.Error(err)
+					return
+				}
+				if buf.String() != want {
+					t.Errorf("concurrent render differs from the sequential one")
+					return
+				}
+			}
+		}()
+	}
+	wg.Wait()
+}
+
+func TestString(t *testing.T) {
+	tests := []struct {
+		name     string
+		got      string
+		expected string
+	}{
+		{"tag", fmt.Sprint(Div(Text("hi")).Class("box")), `<div class="box">hi</div>`},
+		{"verb", fmt.Sprintf("%v", Br()), `<br/>`},
+		{"document", fmt.Sprint(Document(HTML())), `<!DOCTYPE html><html></html>`},
+		{"group", fmt.Sprint(Group(B(), I())), `<b></b><i></i>`},
+		{"error", fmt.Sprint(Div(failingNode{})), `<!-- render error: rendering div: failing node -->`},
+	}
+	for _, test := range tests {
+		if test.got != test.expected {
+			t.Errorf("%s: expected: \"%s\"; got: \"%s\"", test.name, test.expected, test.got)
+		}
+	}
+}
+
+// failingWriter is a writer whose every write fails
+type failingWriter struct{}
+
+func (failingWriter) Write(p []byte) (int, error) {
+	return 0, errors.New("write failed")
+}
+
+func TestRenderErrorPath(t *testing.T) {
+	page := Document(HTML(Body(Main(Div(P(Text("ok")), failingNode{})))))
+	err := page.Render(io.Discard)
+	if !errors.Is(err, errFailingNode) {
+		t.Fatalf("expected the failing node error; got: %v", err)
+	}
+	var re *RenderError
+	if !errors.As(err, &re) {
+		t.Fatalf("expected a RenderError; got: %v", err)
+	}
+	if expected := "html > body > main > div"; re.Path != expected {
+		t.Errorf("expected: \"%s\"; got: \"%s\"", expected, re.Path)
+	}
+	if expected := "rendering html > body > main > div: failing node"; err.Error() != expected {
+		t.Errorf("expected: \"%s\"; got: \"%s\"", expected, err.Error())
+	}
+
+	// Writer errors are wrapped with the element that was being written
+	err = Div(Span(Text("x"))).Render(failingWriter{})
+	if !errors.As(err, &re) || re.Path != "div" {
+		t.Errorf("expected a RenderError at div; got: %v", err)
+	}
+}
+
+func TestHTMLValue(t *testing.T) {
+	value, err := HTMLValue(A(Text("Tom & Jerry")).Href("/?a=1&b=2"))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	tmpl := template.Must(template.New("page").Parse(`<main>{{.Link}} {{.Title}}</main>`))
+	sb := &strings.Builder{}
+	if err := tmpl.Execute(sb, map[string]any{"Link": value, "Title": "<b>"}); err != nil {
+		t.Fatal(err)
+	}
+	if expected := `<main><a href="/?a=1&amp;b=2">Tom &amp; Jerry</a> &lt;b&gt;</main>`; sb.String() != expected {
+		t.Errorf("expected: \"%s\"; got: \"%s\"", expected, sb.String())
+	}
+
+	if value, err := HTMLValue(nil); err != nil || value != "" {
+		t.Errorf("expected an empty value for a nil node, got %q, %v", value, err)
+	}
+	if _, err := HTMLValue(Div(failingNode{})); err == nil {
+		t.Error("expected an error from a failing node")
+	}
+}
+
+// endlessNode writes its chunk until the writer fails, then closes done
+type endlessNode struct {
+	done chan struct{}
+}
+
+func (n endlessNode) Render(w io.Writer) error {
+	defer close(n.done)
+	for {
+		if _, err := io.WriteString(w, "<p>chunk</p>"); err != nil {
+			return err
+		}
+	}
+}
+
+func TestRenderReader(t *testing.T) {
+	r := RenderReader(Div(P(Text("Hello"))).Class("card"))
+	got, err := io.ReadAll(r)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if expected := `<div class="card"><p>Hello</p></div>`; string(got) != expected {
+		t.Errorf("expected: \"%s\"; got: \"%s\"", expected, got)
+	}
+
+	got, err = io.ReadAll(RenderReader(Div(Text("partial"), failingNode{})))
+	var renderErr *RenderError
+	if !errors.As(err, &renderErr) {
+		t.Errorf("expected a RenderError from the reader, got %v", err)
+	}
+	if string(got) != "<div>partial" {
+		t.Errorf("expected the output written before the error, got %q", got)
+	}
+
+	node := endlessNode{done: make(chan struct{})}
+	r = RenderReader(node)
+	if _, err := r.Read(make([]byte, 4)); err != nil {
+		t.Fatal(err)
+	}
+	r.Close()
+	<-node.done
+}
@@ -0,0 +1,194 @@
+/**
+ * Copyright 2025 Alexis Bouchez <alexbcz@proton.me>
+ *
+ * This file is part of libhtml.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ */
+
+package html
+
+import (
+	"html"
+	"io"
+	"slices"
+	"strings"
+
+	nethtml "golang.org/x/net/html"
+)
+
+// urlAttributes lists the attributes holding a URL, whose scheme the
+// sanitizer checks against the policy
+var urlAttributes = map[string]bool{
+	"href": true, "src": true, "cite": true, "action": true, "formaction": true, "poster": true,
+}
+
+// droppedContentElements lists the elements removed by the sanitizer along
+// with everything they contain, rather than only their tags
+var droppedContentElements = map[string]bool{
+	"script": true, "style": true, "iframe": true, "object": true, "embed": true,
+	"noscript": true, "noembed": true, "noframes": true, "template": true, "title": true,
+	"textarea": true, "xmp": true, "svg": true, "math": true,
+}
+
+// SanitizePolicy describes the markup kept when sanitizing untrusted HTML
+type SanitizePolicy struct {
+	// AllowedTags lists the elements kept; the tags of other elements are
+	// dropped while their text content is kept
+	AllowedTags []string
+
+	// AllowedAttributes lists the attributes kept per tag name, the "*" entry
+	// applying to every allowed tag; event handlers (on*) are always dropped
+	AllowedAttributes map[string][]string
+
+	// AllowedURLSchemes lists the schemes allowed in URL attributes such as
+	// href and src, relative URLs and fragments are always allowed
+	AllowedURLSchemes []string
+}
+
+// DefaultSanitizePolicy keeps common formatting, lists, tables, links and images
+var DefaultSanitizePolicy = SanitizePolicy{
+	AllowedTags: []string{
+		"a", "abbr", "b", "blockquote", "br", "caption", "code", "dd", "del", "div", "dl",
+		"dt", "em", "figcaption", "figure", "h1", "h2", "h3", "h4", "h5", "h6", "hr", "i",
+		"img", "ins", "kbd", "li", "mark", "ol", "p", "pre", "q", "s", "small", "span",
+		"strong", "sub", "sup", "table", "tbody", "td", "tfoot", "th", "thead", "tr", "u", "ul",
+	},
+	AllowedAttributes: map[string][]string{
+		"*":          {"title", "lang", "dir"},
+		"a":          {"href"},
+		"img":        {"src", "alt", "width", "height"},
+		"blockquote": {"cite"},
+		"q":          {"cite"},
+		"ol":         {"start"},
+		"td":         {"colspan", "rowspan"},
+		"th":         {"colspan", "rowspan", "scope"},
+	},
+	AllowedURLSchemes: []string{"http", "https", "mailto"},
+}
+
+// Sanitize parses untrusted HTML and returns a node rendering only the markup
+// allowed by DefaultSanitizePolicy
+func Sanitize(dirty string) Node {
+	return DefaultSanitizePolicy.Sanitize(dirty)
+}
+
+// Sanitize parses untrusted HTML and returns a node rendering only the markup
+// allowed by the policy. Scripts, styles and other active content are removed
+// with their content, comments are dropped, text is escaped and every kept
+// element is closed so the result can't leak into the surrounding markup.
+func (p SanitizePolicy) Sanitize(dirty string) Node {
+	sb := &strings.Builder{}
+	z := nethtml.NewTokenizer(strings.NewReader(dirty))
+
+	var open []string
+
+	// skip holds the element whose content is being dropped, and depth how
+	// many of them are currently open
+	skip, depth := "", 0
+
+	for {
+		tt := z.Next()
+		if tt == nethtml.ErrorToken {
+			break
+		}
+		token := z.Token()
+
+		if depth > 0 {
+			switch {
+			case tt == nethtml.StartTagToken && token.Data == skip:
+				depth++
+			case tt == nethtml.EndTagToken && token.Data == skip:
+				depth--
+			}
+			continue
+		}
+
+		switch tt {
+		case nethtml.TextToken:
+			sb.WriteString(html.EscapeString(token.Data))
+
+		case nethtml.StartTagToken, nethtml.SelfClosingTagToken:
+			if droppedContentElements[token.Data] {
+				if tt == nethtml.StartTagToken && !voidElements[token.Data] {
+					skip, depth = token.Data, 1
+				}
+				continue
+			}
+			if !slices.Contains(p.AllowedTags, token.Data) {
+				continue
+			}
+			p.writeStartTag(sb, token)
+			if voidElements[token.Data] {
+				continue
+			}
+			if tt == nethtml.SelfClosingTagToken {
+				sb.WriteString("</" + token.Data + ">")
+				continue
+			}
+			open = append(open, token.Data)
+
+		case nethtml.EndTagToken:
+			i := lastIndex(open, token.Data)
+			if i == -1 {
+				continue
+			}
+			for len(open) > i {
+				sb.WriteString("</" + open[len(open)-1] + ">")
+				open = open[:len(open)-1]
+			}
+		}
+	}
+
+	for len(open) > 0 {
+		sb.WriteString("</" + open[len(open)-1] + ">")
+		open = open[:len(open)-1]
+	}
+
+	return Raw(sb.String())
+}
+
+// writeStartTag writes the opening tag of token with the attributes allowed by the policy
+func (p SanitizePolicy) writeStartTag(w io.StringWriter, token nethtml.Token) {
+	w.WriteString("<" + token.Data)
+	for _, a := range token.Attr {
+		key := strings.ToLower(a.Key)
+		if a.Namespace != "" || strings.HasPrefix(key, "on") {
+			continue
+		}
+		if !slices.Contains(p.AllowedAttributes["*"], key) && !slices.Contains(p.AllowedAttributes[token.Data], key) {
+			continue
+		}
+		value := a.Val
+		if urlAttributes[key] {
+			value = safeURL(value, p.AllowedURLSchemes)
+		}
+		w.WriteString(" " + key + "=\"" + html.EscapeString(value) + "\"")
+	}
+	if voidElements[token.Data] {
+		w.WriteString("/>")
+		return
+	}
+	w.WriteString(">")
+}
+
+// lastIndex returns the index of the last occurrence of v in s, or -1
+func lastIndex(s []string, v string) int {
+	for i := len(s) - 1; i >= 0; i-- {
+		if s[i] == v {
+			return i
+		}
+	}
+	return -1
+}
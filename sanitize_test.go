@@ -0,0 +1,75 @@
+/**
+ * Copyright 2025 Alexis Bouchez <alexbcz@proton.me>
+ *
+ * This file is part of libhtml.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ */
+
+package html_test
+
+import (
+	"testing"
+
+	. "github.com/alexisbcz/libhtml"
+)
+
+func TestSanitize(t *testing.T) {
+	tests := []struct {
+		dirty    string
+		expected string
+	}{
+		{`<p>Hello <b>world</b></p>`, `<p>Hello <b>world</b></p>`},
+		{`<p onclick="alert(1)">x</p>`, `<p>x</p>`},
+		{`<script>alert(1)</script><p>after</p>`, `<p>after</p>`},
+		{`<style>body{display:none}</style>kept`, `kept`},
+		{`<a href="javascript:alert(1)">x</a>`, `<a href="about:blank">x</a>`},
+		{`<a href="https://example.com" target="_blank">x</a>`, `<a href="https://example.com">x</a>`},
+		{`<img src="/a.png" alt="A" onerror="x()">`, `<img src="/a.png" alt="A"/>`},
+		{`<marquee>old <i>school</i></marquee>`, `old <i>school</i>`},
+		{`<p>unclosed <em>tags`, `<p>unclosed <em>tags</em></p>`},
+		{`stray</div> close`, `stray close`},
+		{`<!-- hidden -->text`, `text`},
+		{`1 &lt; 2 &amp;&amp; <3`, `1 &lt; 2 &amp;&amp; &lt;3`},
+		{`<svg><script>alert(1)</script></svg>ok`, `ok`},
+		{`<iframe src="https://evil"><p>x</p></iframe>done`, `done`},
+		{`<div title='a"b'>x</div>`, `<div title="a&#34;b">x</div>`},
+	}
+
+	for _, test := range tests {
+		got, err := RenderString(Sanitize(test.dirty))
+		if err != nil {
+			t.Fatal(err)
+		}
+		if got != test.expected {
+			t.Errorf("Sanitize(%q): expected: \"%s\"; got: \"%s\"", test.dirty, test.expected, got)
+		}
+	}
+}
+
+func TestSanitizePolicy(t *testing.T) {
+	policy := SanitizePolicy{
+		AllowedTags:       []string{"a", "span"},
+		AllowedAttributes: map[string][]string{"*": {"class"}, "a": {"href"}},
+		AllowedURLSchemes: []string{"https"},
+	}
+
+	got, err := RenderString(policy.Sanitize(`<p><span class="x" id="y">a</span> <a href="http://example.com" class="l">b</a></p>`))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if expected := `<span class="x">a</span> <a href="about:blank" class="l">b</a>`; got != expected {
+		t.Errorf("expected: \"%s\"; got: \"%s\"", expected, got)
+	}
+}
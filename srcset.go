@@ -0,0 +1,85 @@
+/**
+ * Copyright 2025 Alexis Bouchez <alexbcz@proton.me>
+ *
+ * This file is part of libhtml.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ */
+
+package html
+
+import (
+	"strconv"
+	"strings"
+)
+
+// SrcsetEntry is one image candidate of a srcset attribute
+type SrcsetEntry struct {
+	// URL is the address of the image
+	URL string
+
+	// Descriptor is the width or pixel density the image is for, such as
+	// "480w" or "2x", and may be left empty for the default 1x
+	Descriptor string
+}
+
+// srcsetEscaper percent-encodes the characters that would split a URL in a
+// srcset value
+var srcsetEscaper = strings.NewReplacer(
+	" ", "%20", "\t", "%09", "\n", "%0A", "\r", "%0D", "\f", "%0C", ",", "%2C",
+)
+
+// Srcset joins entries into a srcset value to pass to img.Srcset or
+// source.Srcset, e.g. "a.jpg 480w, a-large.jpg 1080w". Whitespace and commas
+// in the URLs are percent-encoded so they can't be read as separators.
+func Srcset(entries ...SrcsetEntry) string {
+	candidates := make([]string, 0, len(entries))
+	for _, entry := range entries {
+		candidate := srcsetEscaper.Replace(entry.URL)
+		if entry.Descriptor != "" {
+			candidate += " " + entry.Descriptor
+		}
+		candidates = append(candidates, candidate)
+	}
+	return strings.Join(candidates, ", ")
+}
+
+// SrcsetDensities returns the srcset value of the pixel density variants of
+// base, named with the @2x convention: SrcsetDensities("/a.jpg", 1, 2, 3) is
+// "/a.jpg 1x, /a@2x.jpg 2x, /a@3x.jpg 3x". A density of 1 uses base as is.
+func SrcsetDensities(base string, densities ...int) string {
+	entries := make([]SrcsetEntry, 0, len(densities))
+	for _, density := range densities {
+		url := base
+		if density != 1 {
+			url = densityURL(base, density)
+		}
+		entries = append(entries, SrcsetEntry{URL: url, Descriptor: strconv.Itoa(density) + "x"})
+	}
+	return Srcset(entries...)
+}
+
+// densityURL inserts "@<density>x" before the file extension of u, keeping
+// any query string or fragment after it
+func densityURL(u string, density int) string {
+	rest := ""
+	if i := strings.IndexAny(u, "?#"); i >= 0 {
+		u, rest = u[:i], u[i:]
+	}
+	ext := ""
+	if i := strings.LastIndexByte(u, '.'); i > strings.LastIndexByte(u, '/') {
+		u, ext = u[:i], u[i:]
+	}
+	return u + "@" + strconv.Itoa(density) + "x" + ext + rest
+}
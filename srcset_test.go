@@ -0,0 +1,51 @@
+/**
+ * Copyright 2025 Alexis Bouchez <alexbcz@proton.me>
+ *
+ * This file is part of libhtml.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ */
+
+package html_test
+
+import (
+	"testing"
+
+	. "github.com/alexisbcz/libhtml"
+)
+
+func TestSrcset(t *testing.T) {
+	tests := []struct {
+		got      string
+		expected string
+	}{
+		{Srcset(SrcsetEntry{"/a-480.jpg", "480w"}, SrcsetEntry{"/a-1080.jpg", "1080w"}), "/a-480.jpg 480w, /a-1080.jpg 1080w"},
+		{Srcset(SrcsetEntry{URL: "/a.jpg"}, SrcsetEntry{"/my photo,1.jpg", "2x"}), "/a.jpg, /my%20photo%2C1.jpg 2x"},
+		{Srcset(), ""},
+		{SrcsetDensities("/img/logo.png", 1, 2, 3), "/img/logo.png 1x, /img/logo@2x.png 2x, /img/logo@3x.png 3x"},
+		{SrcsetDensities("/logo.png?v=2", 2), "/logo@2x.png?v=2 2x"},
+		{SrcsetDensities("/logo", 2), "/logo@2x 2x"},
+	}
+
+	for _, test := range tests {
+		if test.got != test.expected {
+			t.Errorf("expected: \"%s\"; got: \"%s\"", test.expected, test.got)
+		}
+	}
+
+	got := MustRenderString(Img().Src("/a.jpg").Srcset(SrcsetDensities("/a.jpg", 1, 2)))
+	if expected := `<img src="/a.jpg" srcset="/a.jpg 1x, /a@2x.jpg 2x"/>`; got != expected {
+		t.Errorf("expected: \"%s\"; got: \"%s\"", expected, got)
+	}
+}
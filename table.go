@@ -0,0 +1,86 @@
+/**
+ * Copyright 2025 Alexis Bouchez <alexbcz@proton.me>
+ *
+ * This file is part of libhtml.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ */
+
+package html
+
+// Column describes a column of a table built by TableOf
+type Column[T any] struct {
+	// Header is the content of the column header cell
+	Header Node
+
+	// Cell returns the content of the column cell for a row
+	Cell func(row T) Node
+
+	// Class is set on the header cell and every cell of the column
+	Class string
+}
+
+// dataTable is a table built from a slice of rows by TableOf
+type dataTable[T any] struct {
+	// Embeds table so the table setters stay available
+	*table
+
+	rows []T
+	trs  []*tr
+}
+
+// TableOf creates a table with a <thead> holding the column headers and a
+// <tbody> holding a row for each element of rows, with a cell per column
+func TableOf[T any](rows []T, cols ...Column[T]) *dataTable[T] {
+	headers := make([]Node, 0, len(cols))
+	for _, col := range cols {
+		th := Th(col.Header)
+		th.Class(col.Class)
+		headers = append(headers, th)
+	}
+
+	trs := make([]*tr, 0, len(rows))
+	body := make([]Node, 0, len(rows))
+	for _, row := range rows {
+		cells := make([]Node, 0, len(cols))
+		for _, col := range cols {
+			var content Node
+			if col.Cell != nil {
+				content = col.Cell(row)
+			}
+			td := Td(content)
+			td.Class(col.Class)
+			cells = append(cells, td)
+		}
+		r := Tr(cells...)
+		trs = append(trs, r)
+		body = append(body, r)
+	}
+
+	return &dataTable[T]{
+		table: Table(Thead(Tr(headers...)), Tbody(body...)),
+		rows:  rows,
+		trs:   trs,
+	}
+}
+
+// RowClass adds the class returned by fn for each row to its <tr>, such as
+// "overdue" for the rows needing attention
+// Returns the table itself to enable method chaining
+func (t *dataTable[T]) RowClass(fn func(row T) string) *dataTable[T] {
+	for i, row := range t.rows {
+		t.trs[i].AddClass(fn(row))
+	}
+	return t
+}
@@ -0,0 +1,64 @@
+/**
+ * Copyright 2025 Alexis Bouchez <alexbcz@proton.me>
+ *
+ * This file is part of libhtml.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ */
+
+package html_test
+
+import (
+	"strconv"
+	"testing"
+
+	. "github.com/alexisbcz/libhtml"
+)
+
+func TestTableOf(t *testing.T) {
+	type invoice struct {
+		ID      int
+		Amount  string
+		Overdue bool
+	}
+	rows := []invoice{{1, "10.00", false}, {2, "<script>", true}}
+
+	got, err := RenderString(TableOf(rows,
+		Column[invoice]{Header: Text("ID"), Cell: func(i invoice) Node { return Text(strconv.Itoa(i.ID)) }},
+		Column[invoice]{Header: Text("Amount"), Cell: func(i invoice) Node { return Text(i.Amount) }, Class: "num"},
+		Column[invoice]{Header: Text("Empty")},
+	).RowClass(func(i invoice) string {
+		if i.Overdue {
+			return "overdue"
+		}
+		return ""
+	}).ID("invoices"))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	expected := `<table id="invoices">` +
+		`<thead><tr><th>ID</th><th class="num">Amount</th><th>Empty</th></tr></thead>` +
+		`<tbody>` +
+		`<tr><td>1</td><td class="num">10.00</td><td></td></tr>` +
+		`<tr class="overdue"><td>2</td><td class="num">&lt;script&gt;</td><td></td></tr>` +
+		`</tbody></table>`
+	if got != expected {
+		t.Errorf("expected: \"%s\"; got: \"%s\"", expected, got)
+	}
+
+	if errs := Validate(TableOf[invoice](nil)); len(errs) != 0 {
+		t.Errorf("expected a valid table; got: %v", errs)
+	}
+}
@@ -0,0 +1,95 @@
+/**
+ * Copyright 2025 Alexis Bouchez <alexbcz@proton.me>
+ *
+ * This file is part of libhtml.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ */
+
+package html
+
+import (
+	"reflect"
+	"strconv"
+)
+
+// TagOption configures a tag built by NewTagWith
+type TagOption func(t *Tag)
+
+// NewTagWith creates an element from options instead of chained setters, for
+// attributes that are computed or passed around, e.g.
+// NewTagWith("div", WithClass("card"), WithAttr("id", "main")). The options are
+// applied in order with the same rules as the matching Tag methods. Elements
+// known to be void, such as "img", are created void. It panics if name isn't a
+// valid tag name, as that could inject markup.
+func NewTagWith(name string, opts ...TagOption) *Tag {
+	mustBeValidTagName(name)
+	t := NewTag(name, voidElements[name], nil)
+	for _, opt := range opts {
+		if opt != nil {
+			opt(t)
+		}
+	}
+	return t
+}
+
+// WithClass sets the class attribute like Tag.Class
+func WithClass(values ...string) TagOption {
+	return func(t *Tag) {
+		t.Class(values...)
+	}
+}
+
+// WithAttr sets an attribute like Tag.Attribute
+func WithAttr(key, value string) TagOption {
+	return func(t *Tag) {
+		t.Attribute(key, value)
+	}
+}
+
+// Attr sets an attribute from a typed value, sparing the strconv calls:
+// integers are formatted in base 10, strings are set like Tag.Attribute and
+// booleans follow boolean attribute semantics, true setting the bare
+// attribute like Tag.BooleanAttribute and false removing it
+func Attr[T ~int | ~string | ~bool](key string, v T) TagOption {
+	return func(t *Tag) {
+		rv := reflect.ValueOf(v)
+		switch rv.Kind() {
+		case reflect.Int:
+			t.Attribute(key, strconv.FormatInt(rv.Int(), 10))
+		case reflect.String:
+			t.Attribute(key, rv.String())
+		case reflect.Bool:
+			if rv.Bool() {
+				t.BooleanAttribute(key)
+			} else {
+				t.RemoveAttribute(key)
+			}
+		}
+	}
+}
+
+// WithAttrs merges attributes like Tag.Attrs
+func WithAttrs(attrs Attribute) TagOption {
+	return func(t *Tag) {
+		t.Attrs(attrs)
+	}
+}
+
+// WithChildren appends children like Tag.Append
+func WithChildren(children ...Node) TagOption {
+	return func(t *Tag) {
+		t.Append(children...)
+	}
+}
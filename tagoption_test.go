@@ -0,0 +1,87 @@
+/**
+ * Copyright 2025 Alexis Bouchez <alexbcz@proton.me>
+ *
+ * This file is part of libhtml.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ */
+
+package html_test
+
+import (
+	"testing"
+
+	. "github.com/alexisbcz/libhtml"
+)
+
+func TestNewTagWith(t *testing.T) {
+	tests := []struct {
+		options Node
+		chained Node
+	}{
+		{
+			NewTagWith("div", WithClass("card", "card"), WithAttr("id", "main"), WithChildren(P(Text("Hi")))),
+			Div(P(Text("Hi"))).Class("card").ID("main"),
+		},
+		{
+			NewTagWith("a", WithAttrs(Attribute{"href": "/", "class": "nav"}), WithAttrs(Attribute{"class": "active"}), nil),
+			A().Href("/").Class("nav active"),
+		},
+		{
+			NewTagWith("img", WithAttr("src", "/a.png"), WithAttr("alt", "")),
+			Img().Src("/a.png"),
+		},
+		{
+			NewTagWith("ul", WithChildren(Li(Text("a"))), WithChildren(Li(Text("b")))),
+			Ul(Li(Text("a")), Li(Text("b"))),
+		},
+	}
+
+	for _, test := range tests {
+		got, expected := MustRenderString(test.options), MustRenderString(test.chained)
+		if got != expected {
+			t.Errorf("expected: \"%s\"; got: \"%s\"", expected, got)
+		}
+	}
+
+	defer func() {
+		if recover() == nil {
+			t.Error("expected NewTagWith to panic on an invalid tag name")
+		}
+	}()
+	NewTagWith("div><script")
+}
+
+func TestAttr(t *testing.T) {
+	type level int
+
+	tests := []struct {
+		node     Node
+		expected string
+	}{
+		{NewTagWith("div", Attr("tabindex", -1), Attr("data-level", level(2))), `<div data-level="2" tabindex="-1"></div>`},
+		{NewTagWith("input", Attr("type", "checkbox"), Attr("checked", true), Attr("disabled", false)), `<input checked type="checkbox"/>`},
+		{NewTagWith("input", WithAttr("disabled", "disabled"), Attr("disabled", false)), `<input/>`},
+		{Div().AttributeInt("data-count", 3).TabindexInt(0), `<div data-count="3" tabindex="0"></div>`},
+		{Td().ColspanInt(2).RowspanInt(3), `<td colspan="2" rowspan="3"></td>`},
+		{Textarea().RowsInt(4).ColsInt(40), `<textarea cols="40" rows="4"></textarea>`},
+		{Img().WidthInt(640).HeightInt(480), `<img height="480" width="640"/>`},
+	}
+
+	for _, test := range tests {
+		if got := MustRenderString(test.node); got != test.expected {
+			t.Errorf("expected: \"%s\"; got: \"%s\"", test.expected, got)
+		}
+	}
+}
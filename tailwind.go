@@ -0,0 +1,406 @@
+/**
+ * Copyright 2025 Alexis Bouchez <alexbcz@proton.me>
+ *
+ * This file is part of libhtml.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ */
+
+package html
+
+import (
+	"slices"
+	"strings"
+)
+
+// twKeywords maps the Tailwind utilities without a value to their group
+var twKeywords = map[string]string{
+	"block": "display", "inline-block": "display", "inline": "display", "flex": "display",
+	"inline-flex": "display", "grid": "display", "inline-grid": "display", "table": "display",
+	"table-row": "display", "table-cell": "display", "contents": "display", "flow-root": "display",
+	"list-item": "display", "hidden": "display",
+	"static": "position", "fixed": "position", "absolute": "position", "relative": "position",
+	"sticky":  "position",
+	"visible": "visibility", "invisible": "visibility", "collapse": "visibility",
+	"underline": "text-decoration", "overline": "text-decoration", "line-through": "text-decoration",
+	"no-underline": "text-decoration",
+	"uppercase":    "text-transform", "lowercase": "text-transform", "capitalize": "text-transform",
+	"normal-case": "text-transform",
+	"italic":      "font-style", "not-italic": "font-style",
+	"truncate":    "text-overflow",
+	"antialiased": "font-smoothing", "subpixel-antialiased": "font-smoothing",
+	"sr-only": "sr-only", "not-sr-only": "sr-only",
+	"isolate": "isolation", "isolation-auto": "isolation",
+}
+
+// twPrefixes maps Tailwind utility prefixes to their group, a utility belongs
+// to the group of the longest prefix it starts with. An empty group means the
+// value decides, see twValueGroup.
+var twPrefixes = map[string]string{
+	"p": "p", "px": "px", "py": "py", "pt": "pt", "pr": "pr", "pb": "pb", "pl": "pl", "ps": "ps", "pe": "pe",
+	"m": "m", "mx": "mx", "my": "my", "mt": "mt", "mr": "mr", "mb": "mb", "ml": "ml", "ms": "ms", "me": "me",
+	"space-x": "space-x", "space-y": "space-y", "gap": "gap", "gap-x": "gap-x", "gap-y": "gap-y",
+	"w": "w", "h": "h", "min-w": "min-w", "min-h": "min-h", "max-w": "max-w", "max-h": "max-h", "size": "size",
+	"inset": "inset", "inset-x": "inset-x", "inset-y": "inset-y",
+	"top": "top", "right": "right", "bottom": "bottom", "left": "left", "start": "start", "end": "end",
+	"z": "z", "order": "order", "basis": "basis", "grow": "grow", "shrink": "shrink",
+	"grid-cols": "grid-cols", "grid-rows": "grid-rows", "grid-flow": "grid-flow",
+	"col": "col", "col-span": "col", "col-start": "col-start", "col-end": "col-end",
+	"row": "row", "row-span": "row", "row-start": "row-start", "row-end": "row-end",
+	"auto-cols": "auto-cols", "auto-rows": "auto-rows",
+	"justify": "justify", "justify-items": "justify-items", "justify-self": "justify-self",
+	"items": "items", "content": "content", "self": "self",
+	"place-content": "place-content", "place-items": "place-items", "place-self": "place-self",
+	"opacity": "opacity", "overflow": "overflow", "overflow-x": "overflow-x", "overflow-y": "overflow-y",
+	"overscroll": "overscroll", "overscroll-x": "overscroll-x", "overscroll-y": "overscroll-y",
+	"leading": "leading", "tracking": "tracking", "whitespace": "whitespace", "break": "break",
+	"indent": "indent", "align": "align", "line-clamp": "line-clamp",
+	"cursor": "cursor", "pointer-events": "pointer-events", "select": "select", "resize": "resize",
+	"transition": "transition", "duration": "duration", "ease": "ease", "delay": "delay", "animate": "animate",
+	"scale": "scale", "scale-x": "scale-x", "scale-y": "scale-y", "rotate": "rotate",
+	"translate-x": "translate-x", "translate-y": "translate-y", "skew-x": "skew-x", "skew-y": "skew-y",
+	"origin": "origin", "aspect": "aspect", "columns": "columns", "fill": "fill",
+	"rounded": "rounded", "rounded-s": "rounded-s", "rounded-e": "rounded-e", "rounded-t": "rounded-t",
+	"rounded-r": "rounded-r", "rounded-b": "rounded-b", "rounded-l": "rounded-l",
+	"rounded-ss": "rounded-ss", "rounded-se": "rounded-se", "rounded-ee": "rounded-ee", "rounded-es": "rounded-es",
+	"rounded-tl": "rounded-tl", "rounded-tr": "rounded-tr", "rounded-br": "rounded-br", "rounded-bl": "rounded-bl",
+	"border-spacing": "border-spacing", "border-opacity": "border-opacity", "bg-opacity": "bg-opacity",
+	"text-opacity": "text-opacity", "outline-offset": "outline-offset", "underline-offset": "underline-offset",
+	"blur": "blur", "brightness": "brightness", "contrast": "contrast", "grayscale": "grayscale",
+	"invert": "invert", "saturate": "saturate", "sepia": "sepia", "drop-shadow": "drop-shadow",
+	"backdrop-blur": "backdrop-blur", "mix-blend": "mix-blend", "bg-blend": "bg-blend",
+	"will-change": "will-change", "accent": "accent", "caret": "caret",
+	"text": "", "bg": "", "font": "", "shadow": "", "ring": "", "ring-offset": "", "outline": "",
+	"stroke": "", "decoration": "", "object": "", "flex": "", "list": "",
+	"border": "", "border-x": "", "border-y": "", "border-t": "", "border-r": "", "border-b": "",
+	"border-l": "", "border-s": "", "border-e": "",
+}
+
+// twConflicts lists the groups a utility overrides on top of its own, such as
+// p-4 overriding an earlier px-2
+var twConflicts = map[string][]string{
+	"p":          {"px", "py", "pt", "pr", "pb", "pl", "ps", "pe"},
+	"px":         {"pr", "pl"},
+	"py":         {"pt", "pb"},
+	"m":          {"mx", "my", "mt", "mr", "mb", "ml", "ms", "me"},
+	"mx":         {"mr", "ml"},
+	"my":         {"mt", "mb"},
+	"gap":        {"gap-x", "gap-y"},
+	"size":       {"w", "h"},
+	"inset":      {"inset-x", "inset-y", "top", "right", "bottom", "left", "start", "end"},
+	"inset-x":    {"right", "left"},
+	"inset-y":    {"top", "bottom"},
+	"overflow":   {"overflow-x", "overflow-y"},
+	"overscroll": {"overscroll-x", "overscroll-y"},
+	"scale":      {"scale-x", "scale-y"},
+	"font-size":  {"leading"},
+	"rounded": {"rounded-s", "rounded-e", "rounded-t", "rounded-r", "rounded-b", "rounded-l",
+		"rounded-ss", "rounded-se", "rounded-ee", "rounded-es", "rounded-tl", "rounded-tr", "rounded-br", "rounded-bl"},
+	"rounded-s": {"rounded-ss", "rounded-es"},
+	"rounded-e": {"rounded-se", "rounded-ee"},
+	"rounded-t": {"rounded-tl", "rounded-tr"},
+	"rounded-r": {"rounded-tr", "rounded-br"},
+	"rounded-b": {"rounded-br", "rounded-bl"},
+	"rounded-l": {"rounded-tl", "rounded-bl"},
+	"border-w": {"border-w-x", "border-w-y", "border-w-t", "border-w-r", "border-w-b", "border-w-l",
+		"border-w-s", "border-w-e"},
+	"border-w-x": {"border-w-r", "border-w-l"},
+	"border-w-y": {"border-w-t", "border-w-b"},
+	"border-color": {"border-color-x", "border-color-y", "border-color-t", "border-color-r",
+		"border-color-b", "border-color-l", "border-color-s", "border-color-e"},
+	"border-color-x": {"border-color-r", "border-color-l"},
+	"border-color-y": {"border-color-t", "border-color-b"},
+}
+
+// Tailwind value keywords used to tell apart utilities sharing a prefix
+var (
+	twFontSizes    = []string{"xs", "sm", "base", "lg", "xl", "2xl", "3xl", "4xl", "5xl", "6xl", "7xl", "8xl", "9xl"}
+	twShadowSizes  = []string{"", "2xs", "xs", "sm", "md", "lg", "xl", "2xl", "inner", "none"}
+	twFontWeights  = []string{"thin", "extralight", "light", "normal", "medium", "semibold", "bold", "extrabold", "black"}
+	twLineStyles   = []string{"solid", "dashed", "dotted", "double", "hidden", "none", "wavy"}
+	twBgPositions  = []string{"bottom", "center", "left", "left-bottom", "left-top", "right", "right-bottom", "right-top", "top"}
+	twBgRepeats    = []string{"repeat", "no-repeat", "repeat-x", "repeat-y", "repeat-round", "repeat-space"}
+	twObjectFits   = []string{"contain", "cover", "fill", "none", "scale-down"}
+	twTextAligns   = []string{"left", "center", "right", "justify", "start", "end"}
+	twFlexDirs     = []string{"row", "row-reverse", "col", "col-reverse"}
+	twFlexWraps    = []string{"wrap", "wrap-reverse", "nowrap"}
+	twListTypes    = []string{"none", "disc", "decimal"}
+	twTextWraps    = []string{"wrap", "nowrap", "balance", "pretty"}
+	twBorderSides  = []string{"x", "y", "t", "r", "b", "l", "s", "e"}
+	twBgAttachment = []string{"fixed", "local", "scroll"}
+	twBgSizes      = []string{"auto", "cover", "contain"}
+)
+
+// TwMerge joins Tailwind CSS class lists like Classes, resolving conflicting
+// utilities so the last one wins, the way the tailwind-merge JavaScript
+// package does: TwMerge("px-2 py-1 bg-red-500", "p-4 bg-blue-500") is
+// "p-4 bg-blue-500". Utilities only conflict under the same variants, so
+// "p-2 md:p-4" is kept as is, and a shorthand overrides its longhands written
+// before it but not after, so "p-4 px-2" is kept too. Classes that aren't
+// known Tailwind utilities never conflict.
+func TwMerge(classes ...string) string {
+	tokens := strings.Fields(strings.Join(classes, " "))
+	seen := make(map[string]bool, len(tokens))
+	kept := make([]string, 0, len(tokens))
+	for _, token := range slices.Backward(tokens) {
+		variants, group, ok := twParse(token)
+		if !ok {
+			kept = append(kept, token)
+			continue
+		}
+		if seen[variants+group] {
+			continue
+		}
+		seen[variants+group] = true
+		for _, c := range twConflicts[group] {
+			seen[variants+c] = true
+		}
+		kept = append(kept, token)
+	}
+	slices.Reverse(kept)
+	return Classes(kept...)
+}
+
+// TwClass adds classes to the current "class" attribute with TwMerge, so they
+// override the conflicting utilities already set, which suits components
+// accepting class overrides from their callers
+// Returns the element itself to enable method chaining
+func (e *Tag) TwClass(values ...string) *Tag {
+	e.Attribute("class", TwMerge(append([]string{e.attributes["class"]}, values...)...))
+	return e
+}
+
+// twParse splits a Tailwind class into its conflict key prefix, made of the
+// sorted variants and the important flag, and the group of its utility
+func twParse(class string) (variants, group string, ok bool) {
+	var parts []string
+	depth, start := 0, 0
+	for i := 0; i < len(class); i++ {
+		switch class[i] {
+		case '[':
+			depth++
+		case ']':
+			depth--
+		case ':':
+			if depth == 0 {
+				parts = append(parts, class[start:i])
+				start = i + 1
+			}
+		}
+	}
+	base := class[start:]
+
+	important := false
+	if strings.HasPrefix(base, "!") {
+		base, important = base[1:], true
+	} else if strings.HasSuffix(base, "!") {
+		base, important = base[:len(base)-1], true
+	}
+	base = strings.TrimPrefix(base, "-")
+
+	group, ok = twGroup(base)
+	if !ok {
+		return "", "", false
+	}
+	slices.Sort(parts)
+	variants = strings.Join(parts, ":") + ":"
+	if important {
+		variants += "!"
+	}
+	return variants, group, true
+}
+
+// twGroup returns the group of a Tailwind utility without variants
+func twGroup(base string) (string, bool) {
+	if group, ok := twKeywords[base]; ok {
+		return group, true
+	}
+
+	// Try the prefixes from the longest, ignoring dashes in arbitrary values
+	end := len(base)
+	if i := strings.IndexByte(base, '['); i >= 0 {
+		end = i
+	}
+	for prefix := base; ; {
+		if len(prefix) <= end {
+			if group, ok := twPrefixes[prefix]; ok {
+				if group != "" {
+					return group, true
+				}
+				value := strings.TrimPrefix(base[len(prefix):], "-")
+				return twValueGroup(prefix, value), true
+			}
+		}
+		i := strings.LastIndexByte(prefix, '-')
+		if i <= 0 {
+			return "", false
+		}
+		prefix = prefix[:i]
+	}
+}
+
+// twValueGroup returns the group of a utility whose prefix is shared by
+// several properties, telling them apart by value as in text-lg and text-red-500
+func twValueGroup(prefix, value string) string {
+	// Opacity and line height modifiers such as bg-red-500/50 don't matter
+	if i := strings.LastIndexByte(value, '/'); i >= 0 && !strings.HasSuffix(value, "]") {
+		value = value[:i]
+	}
+	length := value == "" || twIsNumber(value) || twArbitraryIs(value, "length")
+
+	switch prefix {
+	case "text":
+		switch {
+		case slices.Contains(twFontSizes, value) || twArbitraryIs(value, "length"):
+			return "font-size"
+		case slices.Contains(twTextAligns, value):
+			return "text-align"
+		case slices.Contains(twTextWraps, value):
+			return "text-wrap"
+		case value == "ellipsis" || value == "clip":
+			return "text-overflow"
+		}
+		return "text-color"
+	case "bg":
+		switch {
+		case slices.Contains(twBgAttachment, value):
+			return "bg-attachment"
+		case slices.Contains(twBgSizes, value):
+			return "bg-size"
+		case slices.Contains(twBgPositions, value):
+			return "bg-position"
+		case slices.Contains(twBgRepeats, value):
+			return "bg-repeat"
+		case strings.HasPrefix(value, "clip-"):
+			return "bg-clip"
+		case strings.HasPrefix(value, "origin-"):
+			return "bg-origin"
+		case value == "none" || strings.HasPrefix(value, "gradient-") || twArbitraryIs(value, "url"):
+			return "bg-image"
+		}
+		return "bg-color"
+	case "font":
+		if slices.Contains(twFontWeights, value) || twIsNumber(strings.Trim(value, "[]")) {
+			return "font-weight"
+		}
+		return "font-family"
+	case "shadow":
+		if slices.Contains(twShadowSizes, value) {
+			return "shadow"
+		}
+		return "shadow-color"
+	case "ring":
+		if length || value == "inset" {
+			return "ring-w"
+		}
+		return "ring-color"
+	case "ring-offset":
+		if length {
+			return "ring-offset-w"
+		}
+		return "ring-offset-color"
+	case "outline":
+		switch {
+		case length:
+			return "outline-w"
+		case slices.Contains(twLineStyles, value):
+			return "outline-style"
+		}
+		return "outline-color"
+	case "stroke":
+		if length {
+			return "stroke-w"
+		}
+		return "stroke"
+	case "decoration":
+		switch {
+		case length || value == "auto" || value == "from-font":
+			return "decoration-thickness"
+		case slices.Contains(twLineStyles, value):
+			return "decoration-style"
+		}
+		return "decoration-color"
+	case "object":
+		if slices.Contains(twObjectFits, value) {
+			return "object-fit"
+		}
+		return "object-position"
+	case "flex":
+		switch {
+		case slices.Contains(twFlexDirs, value):
+			return "flex-direction"
+		case slices.Contains(twFlexWraps, value):
+			return "flex-wrap"
+		}
+		return "flex"
+	case "list":
+		if value == "inside" || value == "outside" {
+			return "list-position"
+		}
+		if slices.Contains(twListTypes, value) {
+			return "list-type"
+		}
+		return "list-image"
+	}
+
+	// Border utilities, optionally on a side as in border-t-2
+	side := ""
+	if s := strings.TrimPrefix(prefix, "border-"); s != prefix && slices.Contains(twBorderSides, s) {
+		side = "-" + s
+	}
+	switch {
+	case length:
+		return "border-w" + side
+	case side == "" && slices.Contains(twLineStyles, value):
+		return "border-style"
+	case side == "" && (value == "collapse" || value == "separate"):
+		return "border-collapse"
+	}
+	return "border-color" + side
+}
+
+// twIsNumber reports whether value is a plain number such as 2 or 0.5
+func twIsNumber(value string) bool {
+	if value == "" {
+		return false
+	}
+	for _, c := range value {
+		if (c < '0' || c > '9') && c != '.' {
+			return false
+		}
+	}
+	return true
+}
+
+// twArbitraryIs reports whether value is an arbitrary value of the given kind,
+// "length" or "url", going by an explicit type hint like [length:2px] or else
+// by its shape
+func twArbitraryIs(value, kind string) bool {
+	if !strings.HasPrefix(value, "[") || !strings.HasSuffix(value, "]") {
+		return false
+	}
+	v := value[1 : len(value)-1]
+	if hint, _, ok := strings.Cut(v, ":"); ok && !strings.Contains(hint, "(") {
+		return hint == kind
+	}
+	switch kind {
+	case "url":
+		return strings.HasPrefix(v, "url(")
+	case "length":
+		return v != "" && (v[0] >= '0' && v[0] <= '9' || v[0] == '.' || v[0] == '-' ||
+			strings.HasPrefix(v, "calc(") || strings.HasPrefix(v, "clamp(") ||
+			strings.HasPrefix(v, "min(") || strings.HasPrefix(v, "max("))
+	}
+	return false
+}
@@ -0,0 +1,70 @@
+/**
+ * Copyright 2025 Alexis Bouchez <alexbcz@proton.me>
+ *
+ * This file is part of libhtml.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ */
+
+package html_test
+
+import (
+	"testing"
+
+	. "github.com/alexisbcz/libhtml"
+)
+
+func TestTwMerge(t *testing.T) {
+	tests := []struct {
+		classes  []string
+		expected string
+	}{
+		{[]string{"px-2 py-1", "p-4"}, "p-4"},
+		{[]string{"p-4", "px-2"}, "p-4 px-2"},
+		{[]string{"p-2 px-4 pl-1"}, "p-2 px-4 pl-1"},
+		{[]string{"px-4 pl-1", "px-2"}, "px-2"},
+		{[]string{"p-2", "md:p-4", "hover:p-1"}, "p-2 md:p-4 hover:p-1"},
+		{[]string{"hover:md:p-2", "md:hover:p-4"}, "md:hover:p-4"},
+		{[]string{"p-2", "!p-4", "p-6"}, "!p-4 p-6"},
+		{[]string{"-mt-2 mt-4"}, "mt-4"},
+		{[]string{"text-sm text-red-500 text-center", "text-lg text-blue-500"}, "text-center text-lg text-blue-500"},
+		{[]string{"leading-7 text-lg"}, "text-lg"},
+		{[]string{"text-lg leading-7"}, "text-lg leading-7"},
+		{[]string{"bg-red-500 bg-cover", "bg-blue-500/50"}, "bg-cover bg-blue-500/50"},
+		{[]string{"border border-red-500", "border-2 border-dashed"}, "border-red-500 border-2 border-dashed"},
+		{[]string{"border-t-2 border-x-4 border-0"}, "border-0"},
+		{[]string{"font-bold font-sans font-medium"}, "font-sans font-medium"},
+		{[]string{"rounded-tl-lg rounded-t-md rounded"}, "rounded"},
+		{[]string{"w-4 h-4", "size-8"}, "size-8"},
+		{[]string{"block hidden flex"}, "flex"},
+		{[]string{"shadow-lg shadow-red-500 shadow-none"}, "shadow-red-500 shadow-none"},
+		{[]string{"w-[calc(100%-2rem)] w-full"}, "w-full"},
+		{[]string{"text-[14px] text-[#fff] text-base"}, "text-[#fff] text-base"},
+		{[]string{"card btn p-2", "card p-3"}, "card btn p-3"},
+	}
+
+	for _, test := range tests {
+		if got := TwMerge(test.classes...); got != test.expected {
+			t.Errorf("TwMerge(%q): expected: \"%s\"; got: \"%s\"", test.classes, test.expected, got)
+		}
+	}
+}
+
+func TestTwClass(t *testing.T) {
+	button := Button(Text("Save")).Class("px-4 py-2 bg-gray-200 rounded").TwClass("bg-blue-600", "px-6")
+	expected := `<button class="py-2 rounded bg-blue-600 px-6">Save</button>`
+	if got := MustRenderString(button); got != expected {
+		t.Errorf("expected: \"%s\"; got: \"%s\"", expected, got)
+	}
+}
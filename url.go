@@ -0,0 +1,96 @@
+/**
+ * Copyright 2025 Alexis Bouchez <alexbcz@proton.me>
+ *
+ * This file is part of libhtml.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ */
+
+package html
+
+import (
+	"slices"
+	"strings"
+)
+
+// SafeURLSchemes lists the URL schemes SafeURL lets through
+// Relative URLs and fragments are always allowed
+var SafeURLSchemes = []string{"http", "https", "mailto", "tel"}
+
+// UnsafeURLReplacement is the URL SafeURL returns in place of a disallowed one
+const UnsafeURLReplacement = "about:blank"
+
+// SafeURL returns u when its scheme is one of SafeURLSchemes or when it has no
+// scheme (relative URLs and fragments), and UnsafeURLReplacement otherwise,
+// so user-supplied values like "javascript:alert(1)" can't run code
+func SafeURL(u string) string {
+	return safeURL(u, SafeURLSchemes)
+}
+
+// safeURL returns u when it is relative or its scheme is one of schemes, and
+// UnsafeURLReplacement otherwise
+func safeURL(u string, schemes []string) string {
+	// Browsers ignore surrounding whitespace as well as tabs and newlines
+	// inside a URL, so "java\tscript:" must be treated like "javascript:"
+	cleaned := strings.Map(func(r rune) rune {
+		if r == '\t' || r == '\n' || r == '\r' {
+			return -1
+		}
+		return r
+	}, strings.TrimSpace(u))
+
+	i := strings.IndexAny(cleaned, ":/?#")
+	if i == -1 || cleaned[i] != ':' {
+		return u
+	}
+	if slices.Contains(schemes, strings.ToLower(cleaned[:i])) {
+		return u
+	}
+	return UnsafeURLReplacement
+}
+
+// SafeHref sets the "href" attribute after filtering the URL with SafeURL
+// Use Href to set the URL without any filtering
+func (e *a) SafeHref(value string) *a {
+	e.Attribute("href", SafeURL(value))
+	return e
+}
+
+// SafeHref sets the "href" attribute after filtering the URL with SafeURL
+// Use Href to set the URL without any filtering
+func (e *link) SafeHref(value string) *link {
+	e.Attribute("href", SafeURL(value))
+	return e
+}
+
+// SafeSrc sets the "src" attribute after filtering the URL with SafeURL
+// Use Src to set the URL without any filtering
+func (e *img) SafeSrc(value string) *img {
+	e.Attribute("src", SafeURL(value))
+	return e
+}
+
+// SafeSrc sets the "src" attribute after filtering the URL with SafeURL
+// Use Src to set the URL without any filtering
+func (e *script) SafeSrc(value string) *script {
+	e.Attribute("src", SafeURL(value))
+	return e
+}
+
+// SafeSrc sets the "src" attribute after filtering the URL with SafeURL
+// Use Src to set the URL without any filtering
+func (e *iframe) SafeSrc(value string) *iframe {
+	e.Attribute("src", SafeURL(value))
+	return e
+}
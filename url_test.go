@@ -0,0 +1,89 @@
+/**
+ * Copyright 2025 Alexis Bouchez <alexbcz@proton.me>
+ *
+ * This file is part of libhtml.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ */
+
+package html_test
+
+import (
+	"testing"
+
+	. "github.com/alexisbcz/libhtml"
+)
+
+func TestSafeURL(t *testing.T) {
+	tests := []struct {
+		url      string
+		expected string
+	}{
+		{"https://example.com/a?b=c", "https://example.com/a?b=c"},
+		{"http://example.com", "http://example.com"},
+		{"mailto:john@example.com", "mailto:john@example.com"},
+		{"tel:+33123456789", "tel:+33123456789"},
+		{"/relative/path", "/relative/path"},
+		{"relative/path:with-colon", "relative/path:with-colon"},
+		{"#section", "#section"},
+		{"?page=2", "?page=2"},
+		{"", ""},
+		{"javascript:alert(1)", "about:blank"},
+		{"JavaScript:alert(1)", "about:blank"},
+		{" javascript:alert(1)", "about:blank"},
+		{"java\tscript:alert(1)", "about:blank"},
+		{"data:text/html;base64,PHNjcmlwdD4=", "about:blank"},
+		{"vbscript:msgbox", "about:blank"},
+	}
+
+	for _, test := range tests {
+		if got := SafeURL(test.url); got != test.expected {
+			t.Errorf("SafeURL(%q): expected: \"%s\"; got: \"%s\"", test.url, test.expected, got)
+		}
+	}
+}
+
+func TestSafeHref(t *testing.T) {
+	tests := []struct {
+		node     Node
+		expected string
+	}{
+		{A(Text("x")).SafeHref("javascript:alert(1)"), `<a href="about:blank">x</a>`},
+		{A(Text("x")).SafeHref("/home"), `<a href="/home">x</a>`},
+		{A(Text("x")).Href("javascript:void(0)"), `<a href="javascript:void(0)">x</a>`},
+		{Link().SafeHref("data:text/css,a"), `<link href="about:blank"/>`},
+		{Img().SafeSrc("https://example.com/a.png"), `<img src="https://example.com/a.png"/>`},
+		{Script().SafeSrc("javascript:x"), `<script src="about:blank"></script>`},
+		{Iframe().SafeSrc("https://example.com"), `<iframe src="https://example.com"></iframe>`},
+	}
+
+	for _, test := range tests {
+		got, err := RenderString(test.node)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if got != test.expected {
+			t.Errorf("expected: \"%s\"; got: \"%s\"", test.expected, got)
+		}
+	}
+}
+
+func TestSafeURLSchemes(t *testing.T) {
+	defer func(schemes []string) { SafeURLSchemes = schemes }(SafeURLSchemes)
+	SafeURLSchemes = append(SafeURLSchemes, "ftp")
+
+	if got := SafeURL("ftp://example.com"); got != "ftp://example.com" {
+		t.Errorf("expected configured scheme to be allowed; got: \"%s\"", got)
+	}
+}
@@ -0,0 +1,202 @@
+/**
+ * Copyright 2025 Alexis Bouchez <alexbcz@proton.me>
+ *
+ * This file is part of libhtml.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ */
+
+package html
+
+import (
+	"fmt"
+	"slices"
+	"strings"
+)
+
+// ValidationError reports a content model violation found by Validate
+type ValidationError struct {
+	// Path lists the elements from the root down to the offending node,
+	// e.g. "body > ul > div"
+	Path string
+
+	// Message describes the violation
+	Message string
+}
+
+// Error implements error for ValidationError
+func (e *ValidationError) Error() string {
+	return "html: " + e.Path + ": " + e.Message
+}
+
+// allowedChildren lists the only elements accepted as children of an element,
+// text other than whitespace isn't accepted either
+var allowedChildren = map[string][]string{
+	"ul":       {"li", "script", "template"},
+	"ol":       {"li", "script", "template"},
+	"menu":     {"li", "script", "template"},
+	"dl":       {"dt", "dd", "div", "script", "template"},
+	"table":    {"caption", "colgroup", "thead", "tbody", "tfoot", "script", "template"},
+	"thead":    {"tr", "script", "template"},
+	"tbody":    {"tr", "script", "template"},
+	"tfoot":    {"tr", "script", "template"},
+	"tr":       {"td", "th", "script", "template"},
+	"colgroup": {"col", "template"},
+	"select":   {"option", "optgroup", "hr", "script", "template"},
+	"optgroup": {"option", "script", "template"},
+	"datalist": {"option", "script", "template"},
+}
+
+// allowedParents lists the only elements accepted as parent of an element
+var allowedParents = map[string][]string{
+	"li":         {"ul", "ol", "menu"},
+	"dt":         {"dl", "div"},
+	"dd":         {"dl", "div"},
+	"tr":         {"table", "thead", "tbody", "tfoot"},
+	"td":         {"tr"},
+	"th":         {"tr"},
+	"caption":    {"table"},
+	"colgroup":   {"table"},
+	"col":        {"colgroup"},
+	"thead":      {"table"},
+	"tbody":      {"table"},
+	"tfoot":      {"table"},
+	"option":     {"select", "optgroup", "datalist"},
+	"optgroup":   {"select"},
+	"summary":    {"details"},
+	"legend":     {"fieldset"},
+	"figcaption": {"figure"},
+}
+
+// phrasingElements lists the elements accepting phrasing content only
+var phrasingElements = map[string]bool{
+	"p": true, "span": true, "em": true, "strong": true, "b": true, "i": true, "u": true,
+	"s": true, "small": true, "code": true, "label": true, "abbr": true, "cite": true,
+	"q": true, "sub": true, "sup": true, "mark": true, "kbd": true, "samp": true, "var": true,
+	"time": true, "dfn": true, "bdi": true, "bdo": true, "data": true, "pre": true,
+	"button": true, "h1": true, "h2": true, "h3": true, "h4": true, "h5": true, "h6": true,
+}
+
+// flowElements lists the elements that are flow but not phrasing content
+var flowElements = map[string]bool{
+	"address": true, "article": true, "aside": true, "blockquote": true, "details": true,
+	"dialog": true, "div": true, "dl": true, "fieldset": true, "figure": true, "footer": true,
+	"form": true, "h1": true, "h2": true, "h3": true, "h4": true, "h5": true, "h6": true,
+	"header": true, "hgroup": true, "hr": true, "main": true, "menu": true, "nav": true,
+	"ol": true, "p": true, "pre": true, "section": true, "table": true, "ul": true,
+}
+
+// interactiveElements lists the interactive elements, which can't be nested
+// inside <a> or <button>
+var interactiveElements = map[string]bool{
+	"a": true, "button": true, "details": true, "embed": true, "iframe": true,
+	"input": true, "label": true, "select": true, "textarea": true,
+}
+
+// Validate walks the tree rooted at n and reports the content model violations
+// it finds among the most common ones: wrong children of lists, tables,
+// <select> and <dl>, list items, rows, cells and options outside of their
+// parent, flow content such as <div> inside phrasing elements such as <p> or
+// <span>, interactive content inside <a> or <button>, and void elements such
+// as <img> given children, which are never rendered. Every error is a
+// *ValidationError. Validation is opt-in and meant for tests and development,
+// rendering never validates. Conditionals, maps and groups are looked through,
+// components and other custom nodes are not.
+func Validate(n Node) []error {
+	v := &validator{}
+	v.nodes([]Node{n}, nil)
+	return v.errs
+}
+
+// validator collects the violations found while walking a tree
+type validator struct {
+	errs []error
+}
+
+// report records a violation of the node found below ancestors
+func (v *validator) report(ancestors []*Tag, name, format string, args ...any) {
+	path := make([]string, 0, len(ancestors)+1)
+	for _, a := range ancestors {
+		path = append(path, a.name)
+	}
+	if name != "" {
+		path = append(path, name)
+	}
+	v.errs = append(v.errs, &ValidationError{
+		Path:    strings.Join(path, " > "),
+		Message: fmt.Sprintf(format, args...),
+	})
+}
+
+// nodes validates sibling nodes whose enclosing elements are ancestors
+func (v *validator) nodes(nodes []Node, ancestors []*Tag) {
+	for _, n := range flatten(nodes) {
+		switch c := n.(type) {
+		case *document:
+			v.nodes(c.children, ancestors)
+		case *text:
+			if len(ancestors) == 0 || strings.TrimSpace(c.content) == "" {
+				continue
+			}
+			parent := ancestors[len(ancestors)-1]
+			if allowed, ok := allowedChildren[parent.name]; ok {
+				v.report(ancestors, "", "text is not allowed in <%s>, expected %s", parent.name, tagList(allowed))
+			}
+		case element:
+			v.element(c.base(), ancestors)
+		}
+	}
+}
+
+// element validates t and its descendants
+func (v *validator) element(t *Tag, ancestors []*Tag) {
+	if len(ancestors) > 0 {
+		parent := ancestors[len(ancestors)-1]
+		if allowed, ok := allowedChildren[parent.name]; ok && !slices.Contains(allowed, t.name) {
+			v.report(ancestors, t.name, "<%s> is not allowed in <%s>, expected %s", t.name, parent.name, tagList(allowed))
+		} else if parents, ok := allowedParents[t.name]; ok && !slices.Contains(parents, parent.name) {
+			v.report(ancestors, t.name, "<%s> is not allowed in <%s>, expected a parent %s", t.name, parent.name, tagList(parents))
+		}
+		if phrasingElements[parent.name] && flowElements[t.name] {
+			v.report(ancestors, t.name, "<%s> is not allowed in <%s>, which only accepts phrasing content", t.name, parent.name)
+		}
+		if interactiveElements[t.name] && !(t.name == "input" && t.attributes["type"] == "hidden") {
+			for i := len(ancestors) - 1; i >= 0; i-- {
+				if a := ancestors[i].name; a == "a" || a == "button" {
+					v.report(ancestors, t.name, "interactive <%s> is not allowed inside <%s>", t.name, a)
+					break
+				}
+			}
+		}
+	}
+	if t.isVoid {
+		if len(flatten(t.children)) > 0 {
+			v.report(ancestors, t.name, "void element <%s> can't have children, they are not rendered", t.name)
+		}
+		return
+	}
+	v.nodes(t.children, append(ancestors, t))
+}
+
+// tagList formats names as "<a>, <b> or <c>"
+func tagList(names []string) string {
+	tags := make([]string, len(names))
+	for i, name := range names {
+		tags[i] = "<" + name + ">"
+	}
+	if len(tags) == 1 {
+		return tags[0]
+	}
+	return strings.Join(tags[:len(tags)-1], ", ") + " or " + tags[len(tags)-1]
+}
@@ -0,0 +1,100 @@
+/**
+ * Copyright 2025 Alexis Bouchez <alexbcz@proton.me>
+ *
+ * This file is part of libhtml.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ */
+
+package html_test
+
+import (
+	"errors"
+	"testing"
+
+	. "github.com/alexisbcz/libhtml"
+)
+
+func TestValidate(t *testing.T) {
+	tests := []struct {
+		node     Node
+		expected []string
+	}{
+		{
+			Document(HTML(Body(
+				Ul(Li(Text("ok")), Map([]string{"a"}, func(s string) Node { return Li(Text(s)) })),
+				Table(Thead(Tr(Th(Text("h")))), Tbody(Tr(Td(Text("c"))))),
+				Select(Option(Text("o")), Optgroup(Option(Text("p")))),
+				P(Span(Text("inline")), A(Text("link")).Href("/")),
+				A(Input().Type("hidden")).Href("/"),
+			))),
+			nil,
+		},
+		{
+			Ul(Div(Text("x")), Text(" "), Text("loose")),
+			[]string{
+				"html: ul > div: <div> is not allowed in <ul>, expected <li>, <script> or <template>",
+				"html: ul: text is not allowed in <ul>, expected <li>, <script> or <template>",
+			},
+		},
+		{
+			Div(Img().Append(Text("alt text")), Br().Append(nil), NewTag("hr", true, []Node{Group()})),
+			[]string{"html: div > img: void element <img> can't have children, they are not rendered"},
+		},
+		{
+			Table(Tr(Td())),
+			[]string{"html: table > tr: <tr> is not allowed in <table>, expected <caption>, <colgroup>, <thead>, <tbody>, <tfoot>, <script> or <template>"},
+		},
+		{
+			Div(Li(), Td(), Option()),
+			[]string{
+				"html: div > li: <li> is not allowed in <div>, expected a parent <ul>, <ol> or <menu>",
+				"html: div > td: <td> is not allowed in <div>, expected a parent <tr>",
+				"html: div > option: <option> is not allowed in <div>, expected a parent <select>, <optgroup> or <datalist>",
+			},
+		},
+		{
+			Select(Div()),
+			[]string{"html: select > div: <div> is not allowed in <select>, expected <option>, <optgroup>, <hr>, <script> or <template>"},
+		},
+		{
+			P(Div(), Span(Ul(Li()))),
+			[]string{
+				"html: p > div: <div> is not allowed in <p>, which only accepts phrasing content",
+				"html: p > span > ul: <ul> is not allowed in <span>, which only accepts phrasing content",
+			},
+		},
+		{
+			A(Span(Button(Text("x")))).Href("/"),
+			[]string{"html: a > span > button: interactive <button> is not allowed inside <a>"},
+		},
+	}
+
+	for _, test := range tests {
+		errs := Validate(test.node)
+		if len(errs) != len(test.expected) {
+			t.Errorf("expected %d errors; got: %v", len(test.expected), errs)
+			continue
+		}
+		for i, err := range errs {
+			if err.Error() != test.expected[i] {
+				t.Errorf("expected: %q; got: %q", test.expected[i], err.Error())
+			}
+			var ve *ValidationError
+			if !errors.As(err, &ve) {
+				t.Errorf("expected a *ValidationError; got: %T", err)
+			}
+		}
+	}
+}